@@ -0,0 +1,201 @@
+package manager
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	AccessClaims struct {
+		Issuer    string   `json:"iss"`
+		Subject   string   `json:"sub"`
+		IssuedAt  int64    `json:"iat"`
+		ExpiresAt int64    `json:"exp"`
+		ID        string   `json:"jti"`
+		Squads    []string `json:"squads,omitempty"`
+	}
+
+	accessTokenHeader struct {
+		Algorithm string `json:"alg"`
+		Type      string `json:"typ"`
+		KeyId     string `json:"kid"`
+	}
+
+	TokenSigner struct {
+		Issuer      string
+		TTL         time.Duration
+		ActiveKeyId string
+		keys        map[string][]byte
+		now         func() time.Time
+	}
+
+	Identity struct {
+		PeerId string
+		Squads []string
+		Token  string
+	}
+)
+
+const (
+	ACCESS_TOKEN_ALGORITHM = "HS256"
+	ACCESS_TOKEN_TYPE      = "JWT"
+	ACCESS_TOKEN_LEEWAY    = 30 * time.Second
+	MIN_SIGNING_KEY_SIZE   = 32
+)
+
+const DEFAULT_ACCESS_TOKEN_TTL = 15 * time.Minute
+
+var tokenEncoding = base64.RawURLEncoding
+
+func NewTokenSigner(issuer string, ttl time.Duration, activeKeyId string, keys map[string][]byte) (tokenSigner *TokenSigner, err error) {
+	if _, ok := keys[activeKeyId]; !ok {
+		err = fmt.Errorf("no signing key with id %s", activeKeyId)
+		return
+	}
+	for id, key := range keys {
+		if len(key) < MIN_SIGNING_KEY_SIZE {
+			err = fmt.Errorf("signing key %s must be at least %d bytes long", id, MIN_SIGNING_KEY_SIZE)
+			return
+		}
+	}
+	tokenSigner = &TokenSigner{
+		Issuer:      issuer,
+		TTL:         ttl,
+		ActiveKeyId: activeKeyId,
+		keys:        keys,
+		now:         time.Now,
+	}
+	return
+}
+
+func NewTokenSignerFromConfig(config AuthConfig) (tokenSigner *TokenSigner, err error) {
+	if len(config.SigningKeys) == 0 {
+		return
+	}
+	keys := make(map[string][]byte)
+	for _, signingKey := range config.SigningKeys {
+		if keys[signingKey.ID], err = base64.StdEncoding.DecodeString(signingKey.Secret); err != nil {
+			err = fmt.Errorf("signing key %s is not valid base64 : %v", signingKey.ID, err)
+			return
+		}
+	}
+	tokenSigner, err = NewTokenSigner(config.Issuer, time.Duration(config.AccessTokenTTL), config.ActiveSigningKey, keys)
+	return
+}
+
+func (ts *TokenSigner) Sign(peerId string, squads []string) (token string, claims *AccessClaims, err error) {
+	now := ts.now()
+	claims = &AccessClaims{
+		Issuer:    ts.Issuer,
+		Subject:   peerId,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(ts.TTL).Unix(),
+		ID:        uuid.NewString(),
+		Squads:    squads,
+	}
+	header, err := json.Marshal(&accessTokenHeader{
+		Algorithm: ACCESS_TOKEN_ALGORITHM,
+		Type:      ACCESS_TOKEN_TYPE,
+		KeyId:     ts.ActiveKeyId,
+	})
+	if err != nil {
+		return
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return
+	}
+	signingInput := tokenEncoding.EncodeToString(header) + "." + tokenEncoding.EncodeToString(payload)
+	token = signingInput + "." + tokenEncoding.EncodeToString(ts.sign(ts.keys[ts.ActiveKeyId], signingInput))
+	return
+}
+
+func (ts *TokenSigner) Verify(token string) (claims *AccessClaims, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		err = fmt.Errorf("malformed access token")
+		return
+	}
+	var header accessTokenHeader
+	if err = decodeTokenPart(parts[0], &header); err != nil {
+		return
+	}
+	if header.Algorithm != ACCESS_TOKEN_ALGORITHM {
+		err = fmt.Errorf("unsupported access token algorithm %s", header.Algorithm)
+		return
+	}
+	key, ok := ts.keys[header.KeyId]
+	if !ok {
+		err = fmt.Errorf("unknown access token key %s", header.KeyId)
+		return
+	}
+	signature, err := tokenEncoding.DecodeString(parts[2])
+	if err != nil {
+		err = fmt.Errorf("malformed access token signature")
+		return
+	}
+	if !hmac.Equal(signature, ts.sign(key, parts[0]+"."+parts[1])) {
+		err = fmt.Errorf("invalid access token signature")
+		return
+	}
+	claims = &AccessClaims{}
+	if err = decodeTokenPart(parts[1], claims); err != nil {
+		claims = nil
+		return
+	}
+	now := ts.now()
+	switch {
+	case claims.Issuer != ts.Issuer:
+		err = fmt.Errorf("access token issued by %s", claims.Issuer)
+	case claims.Subject == "":
+		err = fmt.Errorf("access token has no subject")
+	case !now.Before(time.Unix(claims.ExpiresAt, 0).Add(ACCESS_TOKEN_LEEWAY)):
+		err = fmt.Errorf("access token expired")
+	case now.Add(ACCESS_TOKEN_LEEWAY).Before(time.Unix(claims.IssuedAt, 0)):
+		err = fmt.Errorf("access token issued in the future")
+	}
+	if err != nil {
+		claims = nil
+	}
+	return
+}
+
+func (ts *TokenSigner) sign(key []byte, signingInput string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
+
+func decodeTokenPart(part string, v interface{}) (err error) {
+	b, err := tokenEncoding.DecodeString(part)
+	if err != nil {
+		return fmt.Errorf("malformed access token")
+	}
+	if err = json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("malformed access token")
+	}
+	return
+}
+
+func isAccessToken(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+func (identity *Identity) HasSquadAccess(squadId string) bool {
+	if len(identity.Squads) == 0 {
+		return true
+	}
+	for _, id := range identity.Squads {
+		if id == squadId {
+			return true
+		}
+	}
+	return false
+}
@@ -1,36 +1,288 @@
 package manager
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/subtle"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"log"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type AuthType string
 
+type Session struct {
+	Token     string
+	PeerId    string
+	KeyId     string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	LastSeen  time.Time
+}
+
 type AuthManager struct {
-	AuthTokenPending map[string]string
-	AuthTokenValid   map[string]string
+	AuthTokenPending map[string]*Challenge
+	Sessions         map[string]*Session
+	SessionTTL       time.Duration
+	SessionStore     SessionStore
+	TokenSigner      *TokenSigner
+	lastPurge        time.Time
+	now              func() time.Time
+	*sync.RWMutex
 }
 
 const (
-	PWD AuthType = "PWD"
-	RSA AuthType = "RSA"
+	PWD        AuthType = "PWD"
+	RSA        AuthType = "RSA"
+	RSA_PSS    AuthType = "RSA_PSS"
+	ED25519    AuthType = "ED25519"
+	ECDSA_P256 AuthType = "ECDSA_P256"
 )
 
-func NewAuthManager() (authManager *AuthManager) {
+const DEFAULT_SESSION_TTL = 24 * time.Hour
+
+const (
+	SESSION_PURGE_INTERVAL   = time.Minute
+	SESSION_PERSIST_INTERVAL = time.Minute
+)
+
+func NewAuthManager(sessionTTL time.Duration, sessionStore SessionStore) (authManager *AuthManager) {
 	authManager = &AuthManager{
-		AuthTokenPending: make(map[string]string),
-		AuthTokenValid:   make(map[string]string),
+		AuthTokenPending: make(map[string]*Challenge),
+		Sessions:         make(map[string]*Session),
+		SessionTTL:       sessionTTL,
+		SessionStore:     sessionStore,
+		now:              time.Now,
+		RWMutex:          &sync.RWMutex{},
+	}
+	return
+}
+
+func (am *AuthManager) LoadSessions(ctx context.Context) (err error) {
+	if am.SessionStore == nil {
+		return
+	}
+	if err = am.SessionStore.DeleteExpiredSessions(ctx, am.now()); err != nil {
+		return
+	}
+	sessions, err := am.SessionStore.GetSessions(ctx)
+	if err != nil {
+		return
+	}
+	am.Lock()
+	defer am.Unlock()
+	for _, session := range sessions {
+		am.Sessions[session.Token] = session
+	}
+	return
+}
+
+func (am *AuthManager) IssueSession(peerId string, keyId string, token string) (session *Session, err error) {
+	now := am.now()
+	session = &Session{
+		Token:     token,
+		PeerId:    peerId,
+		KeyId:     keyId,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(am.SessionTTL),
+		LastSeen:  now,
+	}
+	if am.SessionStore != nil {
+		if err = am.SessionStore.AddSession(context.Background(), session); err != nil {
+			return
+		}
+	}
+	am.Lock()
+	am.Sessions[token] = session
+	am.Unlock()
+	am.purgeExpiredSessions(now)
+	return
+}
+
+func (am *AuthManager) ValidateSession(token string) (peerId string, err error) {
+	now := am.now()
+	am.Lock()
+	session, ok := am.Sessions[token]
+	if !ok && am.SessionStore != nil {
+		if s, e := am.SessionStore.GetSession(context.Background(), token); e == nil {
+			session, ok = s, true
+			am.Sessions[token] = s
+		}
+	}
+	if !ok {
+		am.Unlock()
+		err = fmt.Errorf("not a valid token provided")
+		return
+	}
+	if !now.Before(session.ExpiresAt) {
+		delete(am.Sessions, token)
+		am.Unlock()
+		if am.SessionStore != nil {
+			_ = am.SessionStore.DeleteSession(context.Background(), token)
+		}
+		err = fmt.Errorf("session expired")
+		return
+	}
+	persist := now.Sub(session.LastSeen) > SESSION_PERSIST_INTERVAL
+	session.LastSeen, session.ExpiresAt = now, now.Add(am.SessionTTL)
+	peerId = session.PeerId
+	am.Unlock()
+	if persist && am.SessionStore != nil {
+		if e := am.SessionStore.UpdateSessionExpiry(context.Background(), token, now, now.Add(am.SessionTTL)); e != nil {
+			log.Println(e)
+		}
+	}
+	return
+}
+
+func (am *AuthManager) Authenticate(token string) (identity *Identity, err error) {
+	if am.TokenSigner != nil && isAccessToken(token) {
+		claims, e := am.TokenSigner.Verify(token)
+		if e != nil {
+			err = e
+			return
+		}
+		identity = &Identity{PeerId: claims.Subject, Squads: claims.Squads, Token: token}
+		return
+	}
+	peerId, err := am.ValidateSession(token)
+	if err != nil {
+		return
+	}
+	identity = &Identity{PeerId: peerId, Token: token}
+	return
+}
+
+func (am *AuthManager) RevokeSession(token string) (err error) {
+	am.Lock()
+	delete(am.Sessions, token)
+	am.Unlock()
+	if am.SessionStore != nil {
+		err = am.SessionStore.DeleteSession(context.Background(), token)
 	}
 	return
 }
 
-func (am *AuthManager) GenerateAuthToken(peerId string, publicKey string) (encryptedToken []byte, err error) {
+func (am *AuthManager) RevokePeerSessions(peerId string) (err error) {
+	am.Lock()
+	for token, session := range am.Sessions {
+		if session.PeerId == peerId {
+			delete(am.Sessions, token)
+		}
+	}
+	am.Unlock()
+	if am.SessionStore != nil {
+		err = am.SessionStore.DeletePeerSessions(context.Background(), peerId)
+	}
+	return
+}
+
+func (am *AuthManager) RevokeKeySessions(peerId string, keyId string) (err error) {
+	am.Lock()
+	for token, session := range am.Sessions {
+		if session.PeerId == peerId && session.KeyId == keyId {
+			delete(am.Sessions, token)
+		}
+	}
+	delete(am.AuthTokenPending, challengeId(peerId, keyId))
+	am.Unlock()
+	if am.SessionStore != nil {
+		err = am.SessionStore.DeleteKeySessions(context.Background(), peerId, keyId)
+	}
+	return
+}
+
+func (am *AuthManager) purgeExpiredSessions(now time.Time) {
+	am.Lock()
+	if now.Sub(am.lastPurge) < SESSION_PURGE_INTERVAL {
+		am.Unlock()
+		return
+	}
+	am.lastPurge = now
+	for token, session := range am.Sessions {
+		if !now.Before(session.ExpiresAt) {
+			delete(am.Sessions, token)
+		}
+	}
+	am.Unlock()
+	if am.SessionStore != nil {
+		if err := am.SessionStore.DeleteExpiredSessions(context.Background(), now); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+func challengeId(peerId string, keyId string) string {
+	return peerId + "/" + keyId
+}
+
+func (am *AuthManager) PendingChallenge(peerId string, keyId string) bool {
+	am.RLock()
+	defer am.RUnlock()
+	challenge, ok := am.AuthTokenPending[challengeId(peerId, keyId)]
+	return ok && am.now().Before(challenge.ExpiresAt)
+}
+
+func (am *AuthManager) GenerateChallenge(peerId string, keyId string, peerKey string) (challenge []byte, authType AuthType, err error) {
+	publicKey, authType, err := ParsePeerKey(peerKey)
+	if err != nil {
+		return
+	}
+	if authType == RSA {
+		challenge, err = am.GenerateAuthToken(challengeId(peerId, keyId), peerKey)
+		return
+	}
+	nonce := make([]byte, CHALLENGE_NONCE_SIZE)
+	if _, err = rand.Read(nonce); err != nil {
+		return
+	}
+	am.Lock()
+	am.AuthTokenPending[challengeId(peerId, keyId)] = &Challenge{
+		Type:      authType,
+		Nonce:     nonce,
+		PublicKey: publicKey,
+		ExpiresAt: am.now().Add(CHALLENGE_TTL),
+	}
+	am.Unlock()
+	challenge = nonce
+	return
+}
+
+func (am *AuthManager) VerifyChallenge(peerId string, keyId string, response []byte) (token string, err error) {
+	am.Lock()
+	challenge, ok := am.AuthTokenPending[challengeId(peerId, keyId)]
+	delete(am.AuthTokenPending, challengeId(peerId, keyId))
+	am.Unlock()
+	if !ok {
+		err = fmt.Errorf("the peer %s have not initiated auth", peerId)
+		return
+	}
+	if !am.now().Before(challenge.ExpiresAt) {
+		err = fmt.Errorf("authentification challenge expired")
+		return
+	}
+	if challenge.Type == RSA {
+		if subtle.ConstantTimeCompare(challenge.Nonce, response) != 1 {
+			err = fmt.Errorf("authentification failed wrong key")
+			return
+		}
+		token = string(challenge.Nonce)
+		return
+	}
+	if err = verifySignature(challenge, response); err != nil {
+		return
+	}
+	token = uuid.NewString()
+	return
+}
+
+func (am *AuthManager) GenerateAuthToken(challengeId string, publicKey string) (encryptedToken []byte, err error) {
 	encryptedTokenCh, errCh := make(chan []byte), make(chan error)
 	go func() {
 		token, e := uuid.NewRandom()
@@ -38,7 +290,6 @@ func (am *AuthManager) GenerateAuthToken(peerId string, publicKey string) (encry
 			errCh <- e
 			return
 		}
-		fmt.Println("token:", token)
 		pubKey, e := am.parsePublicKey(publicKey)
 		if e != nil {
 			errCh <- fmt.Errorf("error in parse pub key : %v", e)
@@ -49,7 +300,14 @@ func (am *AuthManager) GenerateAuthToken(peerId string, publicKey string) (encry
 			errCh <- fmt.Errorf("error in encrypt with key : %v", e)
 			return
 		}
-		am.AuthTokenPending[peerId] = token.String()
+		am.Lock()
+		am.AuthTokenPending[challengeId] = &Challenge{
+			Type:      RSA,
+			Nonce:     []byte(token.String()),
+			PublicKey: pubKey,
+			ExpiresAt: am.now().Add(CHALLENGE_TTL),
+		}
+		am.Unlock()
 		encryptedTokenCh <- encryptedMsg
 	}()
 	select {
@@ -60,24 +318,22 @@ func (am *AuthManager) GenerateAuthToken(peerId string, publicKey string) (encry
 }
 
 func (am *AuthManager) parsePublicKey(publicKey string) (pubKey *rsa.PublicKey, err error) {
-	pub := []byte(publicKey)
-	block, _ := pem.Decode(pub)
-	b := block.Bytes
-	pubKey, err = x509.ParsePKCS1PublicKey(b)
-	if err != nil {
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		err = fmt.Errorf("invalid PEM public key")
 		return
 	}
+	pubKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
 	return
 }
 
-func (am *AuthManager) parsePrivKey(privateKey string) (pubKey *rsa.PrivateKey, err error) {
-	pub := []byte(privateKey)
-	block, _ := pem.Decode(pub)
-	b := block.Bytes
-	pubKey, err = x509.ParsePKCS1PrivateKey(b)
-	if err != nil {
+func (am *AuthManager) parsePrivKey(privateKey string) (privKey *rsa.PrivateKey, err error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		err = fmt.Errorf("invalid PEM private key")
 		return
 	}
+	privKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
 	return
 }
 
@@ -0,0 +1,356 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+const NODE_SUBJECT_PREFIX = "zippytal.node."
+
+const CLUSTER_EVENTS_SUBJECT = "zippytal.events"
+
+const CONTACTS_CHANGED = "contacts_changed"
+
+const DEFAULT_CLUSTER_PEER_TTL = 30 * time.Second
+
+var ErrNoSubscribers = errors.New("no node listens on this subject")
+
+const (
+	NO_CLUSTER    = "none"
+	REDIS_CLUSTER = "redis"
+)
+
+type Bus interface {
+	Publish(ctx context.Context, subject string, data []byte) error
+	Subscribe(subject string, handler func(data []byte)) (unsubscribe func(), err error)
+	Close() error
+}
+
+type Directory interface {
+	Register(ctx context.Context, peerId string, nodeId string) error
+	Unregister(ctx context.Context, peerId string, nodeId string) error
+	Lookup(ctx context.Context, peerId string) (nodeId string, err error)
+}
+
+type ClusterEvent struct {
+	Type    string            `json:"type"`
+	NodeId  string            `json:"nodeId"`
+	PeerId  string            `json:"peerId"`
+	Payload map[string]string `json:"payload,omitempty"`
+}
+
+type Cluster struct {
+	NodeId      string
+	Bus         Bus
+	Directory   Directory
+	PeerTTL     time.Duration
+	unsubscribe func()
+}
+
+func NewCluster(nodeId string, bus Bus, directory Directory) *Cluster {
+	return &Cluster{
+		NodeId:    nodeId,
+		Bus:       bus,
+		Directory: directory,
+		PeerTTL:   DEFAULT_CLUSTER_PEER_TTL,
+	}
+}
+
+func NewClusterFromConfig(config ClusterConfig) (cluster *Cluster, err error) {
+	switch config.Backend {
+	case NO_CLUSTER:
+		return
+	case REDIS_CLUSTER:
+		bus, e := NewRedisBus(config.RedisAddr, config.RedisPassword)
+		if e != nil {
+			err = e
+			return
+		}
+		directory, e := NewRedisDirectory(config.RedisAddr, config.RedisPassword, time.Duration(config.PeerTTL))
+		if e != nil {
+			bus.Close()
+			err = e
+			return
+		}
+		nodeId := config.NodeId
+		if nodeId == "" {
+			if nodeId, err = os.Hostname(); err != nil {
+				bus.Close()
+				directory.Close()
+				return
+			}
+		}
+		cluster = NewCluster(nodeId, bus, directory)
+		cluster.PeerTTL = time.Duration(config.PeerTTL)
+	default:
+		err = fmt.Errorf("unknown cluster backend %s", config.Backend)
+	}
+	return
+}
+
+func nodeSubject(nodeId string) string {
+	return NODE_SUBJECT_PREFIX + nodeId
+}
+
+func (manager *Manager) JoinCluster(cluster *Cluster) (err error) {
+	unsubscribe, err := cluster.Bus.Subscribe(nodeSubject(cluster.NodeId), func(data []byte) {
+		var envelope Envelope
+		if err := json.Unmarshal(data, &envelope); err != nil {
+			log.Println(err)
+			return
+		}
+		if err := manager.deliver(&envelope); err != nil {
+			log.Println(err)
+		}
+	})
+	if err != nil {
+		return
+	}
+	unsubscribeEvents, err := cluster.Bus.Subscribe(CLUSTER_EVENTS_SUBJECT, func(data []byte) {
+		var event ClusterEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			log.Println(err)
+			return
+		}
+		if event.NodeId != cluster.NodeId {
+			manager.clusterEvent(&event)
+		}
+	})
+	if err != nil {
+		unsubscribe()
+		return
+	}
+	if cluster.PeerTTL <= 0 {
+		cluster.PeerTTL = DEFAULT_CLUSTER_PEER_TTL
+	}
+	stop := make(chan struct{})
+	cluster.unsubscribe = func() {
+		close(stop)
+		unsubscribe()
+		unsubscribeEvents()
+	}
+	manager.Cluster = cluster
+	go manager.refreshDirectory(cluster, stop)
+	return
+}
+
+func (manager *Manager) refreshDirectory(cluster *Cluster, stop <-chan struct{}) {
+	ticker := time.NewTicker(cluster.PeerTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, peerId := range manager.Router.PeerIds() {
+				if err := cluster.Directory.Register(context.Background(), peerId, cluster.NodeId); err != nil {
+					log.Println(err)
+				}
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (manager *Manager) broadcast(eventType string, peerId string, payload map[string]string) {
+	if manager.Cluster == nil {
+		return
+	}
+	data, err := json.Marshal(&ClusterEvent{Type: eventType, NodeId: manager.Cluster.NodeId, PeerId: peerId, Payload: payload})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if err = manager.Cluster.Bus.Publish(context.Background(), CLUSTER_EVENTS_SUBJECT, data); err != nil && !errors.Is(err, ErrNoSubscribers) {
+		log.Println(err)
+	}
+}
+
+func (manager *Manager) clusterEvent(event *ClusterEvent) {
+	switch event.Type {
+	case CONTACTS_CHANGED:
+		manager.Blocks.forget(event.PeerId)
+		manager.SignalGrants.revokePeer(event.PeerId)
+		manager.revalidatePresence(event.PeerId)
+	case string(PRESENCE_CHANGED):
+		manager.remotePresence(event.PeerId, event.Payload)
+	}
+}
+
+func (manager *Manager) forwardToNode(envelope *Envelope) (err error) {
+	nodeId, err := manager.Cluster.Directory.Lookup(context.Background(), envelope.To)
+	if err != nil {
+		return
+	}
+	if nodeId == "" || nodeId == manager.Cluster.NodeId {
+		err = fmt.Errorf("no corresponding peer for id %s", envelope.To)
+		return
+	}
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return
+	}
+	if err = manager.Cluster.Bus.Publish(context.Background(), nodeSubject(nodeId), data); errors.Is(err, ErrNoSubscribers) {
+		if e := manager.Cluster.Directory.Unregister(context.Background(), envelope.To, nodeId); e != nil {
+			log.Println(e)
+		}
+		err = fmt.Errorf("node %s of peer %s is gone", nodeId, envelope.To)
+	}
+	return
+}
+
+func (manager *Manager) reachable(peerId string) bool {
+	if manager.Router.Connected(peerId) {
+		return true
+	}
+	if manager.Cluster == nil {
+		return false
+	}
+	nodeId, err := manager.Cluster.Directory.Lookup(context.Background(), peerId)
+	if err != nil {
+		log.Println(err)
+		return false
+	}
+	return nodeId != "" && nodeId != manager.Cluster.NodeId
+}
+
+func (manager *Manager) registerPeer(peerId string) {
+	if manager.Cluster == nil {
+		return
+	}
+	if err := manager.Cluster.Directory.Register(context.Background(), peerId, manager.Cluster.NodeId); err != nil {
+		log.Println(err)
+	}
+}
+
+func (manager *Manager) unregisterPeer(ctx context.Context, peerId string) {
+	if manager.Cluster == nil {
+		return
+	}
+	if err := manager.Cluster.Directory.Unregister(ctx, peerId, manager.Cluster.NodeId); err != nil {
+		log.Println(err)
+	}
+}
+
+type MemoryBus struct {
+	subscriptions map[string]map[*memorySubscription]bool
+	*sync.RWMutex
+}
+
+type memorySubscription struct {
+	messages chan []byte
+	done     chan struct{}
+	once     *sync.Once
+}
+
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{
+		subscriptions: make(map[string]map[*memorySubscription]bool),
+		RWMutex:       &sync.RWMutex{},
+	}
+}
+
+func (mb *MemoryBus) Publish(ctx context.Context, subject string, data []byte) (err error) {
+	mb.RLock()
+	subscriptions := make([]*memorySubscription, 0, len(mb.subscriptions[subject]))
+	for subscription := range mb.subscriptions[subject] {
+		subscriptions = append(subscriptions, subscription)
+	}
+	mb.RUnlock()
+	if len(subscriptions) == 0 {
+		err = ErrNoSubscribers
+		return
+	}
+	for _, subscription := range subscriptions {
+		select {
+		case subscription.messages <- data:
+		case <-subscription.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return
+}
+
+func (mb *MemoryBus) Subscribe(subject string, handler func(data []byte)) (unsubscribe func(), err error) {
+	subscription := &memorySubscription{
+		messages: make(chan []byte, DEFAULT_OUTBOUND_QUEUE_SIZE),
+		done:     make(chan struct{}),
+		once:     &sync.Once{},
+	}
+	mb.Lock()
+	if _, ok := mb.subscriptions[subject]; !ok {
+		mb.subscriptions[subject] = make(map[*memorySubscription]bool)
+	}
+	mb.subscriptions[subject][subscription] = true
+	mb.Unlock()
+	go func() {
+		for {
+			select {
+			case data := <-subscription.messages:
+				handler(data)
+			case <-subscription.done:
+				return
+			}
+		}
+	}()
+	unsubscribe = func() {
+		mb.Lock()
+		defer mb.Unlock()
+		delete(mb.subscriptions[subject], subscription)
+		subscription.once.Do(func() { close(subscription.done) })
+	}
+	return
+}
+
+func (mb *MemoryBus) Close() (err error) {
+	mb.Lock()
+	defer mb.Unlock()
+	for subject, subscriptions := range mb.subscriptions {
+		for subscription := range subscriptions {
+			subscription.once.Do(func() { close(subscription.done) })
+		}
+		delete(mb.subscriptions, subject)
+	}
+	return
+}
+
+type MemoryDirectory struct {
+	nodes map[string]string
+	*sync.RWMutex
+}
+
+func NewMemoryDirectory() *MemoryDirectory {
+	return &MemoryDirectory{
+		nodes:   make(map[string]string),
+		RWMutex: &sync.RWMutex{},
+	}
+}
+
+func (md *MemoryDirectory) Register(ctx context.Context, peerId string, nodeId string) (err error) {
+	md.Lock()
+	defer md.Unlock()
+	md.nodes[peerId] = nodeId
+	return
+}
+
+func (md *MemoryDirectory) Unregister(ctx context.Context, peerId string, nodeId string) (err error) {
+	md.Lock()
+	defer md.Unlock()
+	if md.nodes[peerId] == nodeId {
+		delete(md.nodes, peerId)
+	}
+	return
+}
+
+func (md *MemoryDirectory) Lookup(ctx context.Context, peerId string) (nodeId string, err error) {
+	md.RLock()
+	defer md.RUnlock()
+	nodeId = md.nodes[peerId]
+	return
+}
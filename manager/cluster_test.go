@@ -0,0 +1,286 @@
+package manager
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClusterRouting(t *testing.T) {
+	bus, directory := NewMemoryBus(), NewMemoryDirectory()
+	defer bus.Close()
+	nodes := []*Manager{NewMemoryManager(), NewMemoryManager()}
+	for i, m := range nodes {
+		m.Resume.Config.GracePeriod = 0
+		cluster := NewCluster([]string{"node1", "node2"}[i], bus, directory)
+		cluster.PeerTTL = 30 * time.Millisecond
+		if err := m.JoinCluster(cluster); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stream, cancel := linkTestPeer(t, nodes[0], "lolo")
+	defer cancel()
+	other, cancelOther := linkTestPeer(t, nodes[1], "lolo2")
+	defer cancelOther()
+	if node, _ := directory.Lookup(context.Background(), "lolo"); node != "node1" {
+		t.Fatalf("expected lolo to be registered on node1, got %q", node)
+	}
+	if err := nodes[1].forward(&Envelope{Type: "offer", From: "lolo2", To: "lolo", Payload: map[string]string{"sdp": "sdp"}}); err != nil {
+		t.Fatal(err)
+	}
+	nodes[1].notifySquad([]string{"lolo", "lolo2", "lolo3"}, "lolo3", INCOMING_MEMBER, map[string]string{"id": "lolo3"})
+	for _, expected := range []string{"offer", string(INCOMING_MEMBER)} {
+		select {
+		case res := <-stream.sent:
+			if res.Type != expected {
+				t.Errorf("expected %s from the other node, got %v", expected, res)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("lolo never received %s from the other node", expected)
+		}
+	}
+	select {
+	case res := <-other.sent:
+		if res.Type != string(INCOMING_MEMBER) {
+			t.Errorf("expected lolo2 to be notified locally, got %v", res)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("lolo2 was not notified")
+	}
+	unlinkTestPeer(t, nodes[0], "lolo", cancel)
+	for i := 0; i < 100; i++ {
+		if node, _ := directory.Lookup(context.Background(), "lolo"); node == "" {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := nodes[1].forward(&Envelope{Type: "offer", From: "lolo2", To: "lolo", Payload: map[string]string{}}); err == nil {
+		t.Error("expected a peer that left the cluster to be unreachable")
+	}
+	if err := directory.Unregister(context.Background(), "lolo2", "node2"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		if node, _ := directory.Lookup(context.Background(), "lolo2"); node == "node2" {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if node, _ := directory.Lookup(context.Background(), "lolo2"); node != "node2" {
+		t.Errorf("expected node2 to refresh the directory entry of lolo2, got %q", node)
+	}
+	if err := directory.Register(context.Background(), "lolo3", "node3"); err != nil {
+		t.Fatal(err)
+	}
+	nodes[0].notifySquad([]string{"lolo3"}, "lolo", KICKED_MEMBER, map[string]string{"squadId": "squad"})
+	if messages, _ := nodes[0].MessageStore.GetMessages(context.Background(), "lolo3", time.Now()); len(messages) != 1 {
+		t.Errorf("expected an event for a peer of a dead node to be stored offline, got %v", messages)
+	}
+	if node, _ := directory.Lookup(context.Background(), "lolo3"); node != "" {
+		t.Errorf("expected the stale entry of lolo3 to be removed, got %q", node)
+	}
+}
+
+func TestClusterBlocks(t *testing.T) {
+	bus, directory := NewMemoryBus(), NewMemoryDirectory()
+	defer bus.Close()
+	nodes := []*Manager{NewMemoryManager(), NewMemoryManager()}
+	nodes[1].PeerStore = nodes[0].PeerStore
+	for i, m := range nodes {
+		if err := m.JoinCluster(NewCluster([]string{"node1", "node2"}[i], bus, directory)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	befriendTestPeers(t, nodes[0], "lolo", "lolo2")
+	if nodes[1].isBlocked("lolo", "lolo2") || !nodes[1].canSignal("lolo2", "lolo", "") {
+		t.Fatal("expected lolo2 to be allowed to signal its friend")
+	}
+	if err := nodes[0].BlockPeer(newTestSession(t, nodes[0], "lolo"), "lolo", "lolo2"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100 && !nodes[1].isBlocked("lolo", "lolo2"); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !nodes[1].isBlocked("lolo", "lolo2") || nodes[1].canSignal("lolo2", "lolo", "") {
+		t.Error("expected the other node to drop its cached contacts of lolo after the block")
+	}
+}
+
+func TestClusterPresence(t *testing.T) {
+	bus, directory := NewMemoryBus(), NewMemoryDirectory()
+	defer bus.Close()
+	nodes := []*Manager{NewMemoryManager(), NewMemoryManager()}
+	nodes[1].PeerStore = nodes[0].PeerStore
+	for i, m := range nodes {
+		if err := m.JoinCluster(NewCluster([]string{"node1", "node2"}[i], bus, directory)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	befriendTestPeers(t, nodes[0], "lolo", "lolo2")
+	stream, cancel := linkTestPeer(t, nodes[0], "lolo")
+	defer cancel()
+	if _, err := nodes[0].SubscribePresence(newTestSession(t, nodes[0], "lolo"), "lolo", []string{"lolo2"}); err != nil {
+		t.Fatal(err)
+	}
+	_, cancelOther := linkTestPeer(t, nodes[1], "lolo2")
+	defer cancelOther()
+	expectPresence(t, stream, "lolo2", ONLINE)
+	if err := nodes[1].BlockPeer(newTestSession(t, nodes[1], "lolo2"), "lolo2", "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100 && len(nodes[0].Presence.links("lolo2")) != 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := nodes[1].SetPresence(newTestSession(t, nodes[1], "lolo2"), "lolo2", AWAY); err != nil {
+		t.Fatal(err)
+	}
+	timeout := time.After(50 * time.Millisecond)
+	for waiting := true; waiting; {
+		select {
+		case res := <-stream.sent:
+			if res.Type == string(PRESENCE_CHANGED) {
+				t.Errorf("expected lolo to stop following lolo2 once blocked, got %v", res)
+			}
+		case <-timeout:
+			waiting = false
+		}
+	}
+}
+
+func redisBulk(s string) string {
+	return "$" + strconv.Itoa(len(s)) + "\r\n" + s + "\r\n"
+}
+
+func newFakeRedis(t *testing.T) (addr string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+	peers, ttls, subscribers, lock := make(map[string]string), make(map[string]string), make(map[string][]net.Conn), &sync.Mutex{}
+	serve := func(conn net.Conn) {
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		for {
+			reply, err := readRedisReply(reader)
+			if err != nil {
+				return
+			}
+			args := make([]string, 0)
+			for _, arg := range reply.([]interface{}) {
+				args = append(args, arg.(string))
+			}
+			lock.Lock()
+			switch strings.ToUpper(args[0]) {
+			case "SET":
+				peers[args[1]], ttls[args[1]] = args[2], ""
+				if len(args) == 5 && strings.ToUpper(args[3]) == "EX" {
+					ttls[args[1]] = args[4]
+				}
+				conn.Write([]byte("+OK\r\n"))
+			case "GET":
+				if nodeId, ok := peers[args[1]]; ok {
+					conn.Write([]byte(redisBulk(nodeId)))
+				} else {
+					conn.Write([]byte("$-1\r\n"))
+				}
+			case "TTL":
+				if ttl, ok := ttls[args[1]]; ok && ttl != "" {
+					conn.Write([]byte(":" + ttl + "\r\n"))
+				} else {
+					conn.Write([]byte(":-1\r\n"))
+				}
+			case "EVAL":
+				if peers[args[3]] == args[4] {
+					delete(peers, args[3])
+					delete(ttls, args[3])
+				}
+				conn.Write([]byte(":1\r\n"))
+			case "SUBSCRIBE":
+				subscribers[args[1]] = append(subscribers[args[1]], conn)
+				conn.Write([]byte("*3\r\n" + redisBulk("subscribe") + redisBulk(args[1]) + ":1\r\n"))
+			case "PUBLISH":
+				for _, subscriber := range subscribers[args[1]] {
+					subscriber.Write([]byte("*3\r\n" + redisBulk("message") + redisBulk(args[1]) + redisBulk(args[2])))
+				}
+				conn.Write([]byte(":" + strconv.Itoa(len(subscribers[args[1]])) + "\r\n"))
+			default:
+				conn.Write([]byte("-ERR unknown command '" + args[0] + "'\r\n"))
+			}
+			lock.Unlock()
+		}
+	}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go serve(conn)
+		}
+	}()
+	return lis.Addr().String()
+}
+
+func TestRedisCluster(t *testing.T) {
+	addr := newFakeRedis(t)
+	cluster, err := NewClusterFromConfig(ClusterConfig{Backend: REDIS_CLUSTER, NodeId: "node1", RedisAddr: addr, PeerTTL: Duration(30 * time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cluster.Bus.Close()
+	defer cluster.Directory.(*RedisDirectory).Close()
+	directory := cluster.Directory
+	if err = directory.Register(context.Background(), "lolo", "node1"); err != nil {
+		t.Fatal(err)
+	}
+	if nodeId, err := directory.Lookup(context.Background(), "lolo"); err != nil || nodeId != "node1" {
+		t.Fatalf("expected lolo on node1, got %q %v", nodeId, err)
+	}
+	if ttl, _ := cluster.Bus.(*RedisBus).conn.Do(context.Background(), "TTL", redisPeerKey("lolo")); ttl != int64(30) {
+		t.Errorf("expected the directory entry of lolo to expire after cluster.peerTTL, got %v", ttl)
+	}
+	if err = directory.Unregister(context.Background(), "lolo", "node2"); err != nil {
+		t.Fatal(err)
+	}
+	if nodeId, _ := directory.Lookup(context.Background(), "lolo"); nodeId != "node1" {
+		t.Error("expected another node not to unregister lolo")
+	}
+	if err = directory.Unregister(context.Background(), "lolo", "node1"); err != nil {
+		t.Fatal(err)
+	}
+	if nodeId, err := directory.Lookup(context.Background(), "lolo"); err != nil || nodeId != "" {
+		t.Errorf("expected lolo to be unregistered, got %q %v", nodeId, err)
+	}
+	received := make(chan []byte, 1)
+	unsubscribe, err := cluster.Bus.Subscribe(nodeSubject("node1"), func(data []byte) { received <- data })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unsubscribe()
+	if err = cluster.Bus.Publish(context.Background(), nodeSubject("node1"), []byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case data := <-received:
+		if string(data) != "hello" {
+			t.Errorf("expected hello, got %s", data)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("message never published")
+	}
+	if _, err = cluster.Bus.(*RedisBus).conn.Do(context.Background(), "FLUSHALL"); err == nil {
+		t.Error("expected redis errors to be reported")
+	}
+	if err = cluster.Bus.Publish(context.Background(), nodeSubject("node1"), []byte("hello")); err != nil {
+		t.Errorf("expected the connection to survive a redis error, got %v", err)
+	}
+	if err = cluster.Bus.Publish(context.Background(), nodeSubject("node2"), []byte("hello")); err != ErrNoSubscribers {
+		t.Errorf("expected a publish nobody receives to fail, got %v", err)
+	}
+}
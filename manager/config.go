@@ -0,0 +1,687 @@
+package manager
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	ListenersConfig struct {
+		GRPC  string `json:"grpc"`
+		WS    string `json:"ws"`
+		HTTPS string `json:"https"`
+	}
+
+	CertificateConfig struct {
+		CertFile string `json:"certFile"`
+		KeyFile  string `json:"keyFile"`
+	}
+
+	TLSConfig struct {
+		Enabled bool              `json:"enabled"`
+		App     CertificateConfig `json:"app"`
+		Website CertificateConfig `json:"website"`
+	}
+
+	DatabaseConfig struct {
+		Backend string `json:"backend"`
+		URI     string `json:"uri"`
+		Name    string `json:"name"`
+	}
+
+	StaticConfig struct {
+		AppHost     string `json:"appHost"`
+		AppDir      string `json:"appDir"`
+		WebsiteHost string `json:"websiteHost"`
+		WebsiteDir  string `json:"websiteDir"`
+	}
+
+	SigningKey struct {
+		ID     string `json:"id"`
+		Secret string `json:"secret"`
+	}
+
+	AuthConfig struct {
+		SessionTTL       Duration     `json:"sessionTTL"`
+		PersistSessions  bool         `json:"persistSessions"`
+		Issuer           string       `json:"issuer"`
+		AccessTokenTTL   Duration     `json:"accessTokenTTL"`
+		SigningKeys      []SigningKey `json:"signingKeys"`
+		ActiveSigningKey string       `json:"activeSigningKey"`
+	}
+
+	GRPCConfig struct {
+		MaxConcurrentStreams uint32   `json:"maxConcurrentStreams"`
+		MaxRecvMsgSize       int      `json:"maxRecvMsgSize"`
+		MaxSendMsgSize       int      `json:"maxSendMsgSize"`
+		KeepaliveTime        Duration `json:"keepaliveTime"`
+		KeepaliveTimeout     Duration `json:"keepaliveTimeout"`
+		KeepaliveMinTime     Duration `json:"keepaliveMinTime"`
+	}
+
+	OutboundConfig struct {
+		QueueSize      int      `json:"queueSize"`
+		OverflowPolicy string   `json:"overflowPolicy"`
+		BlockTimeout   Duration `json:"blockTimeout"`
+	}
+
+	HeartbeatConfig struct {
+		PingInterval Duration `json:"pingInterval"`
+		PongTimeout  Duration `json:"pongTimeout"`
+		EvictAfter   Duration `json:"evictAfter"`
+	}
+
+	ResumeConfig struct {
+		GracePeriod Duration `json:"gracePeriod"`
+		BufferSize  int      `json:"bufferSize"`
+	}
+
+	OfflineConfig struct {
+		MessageTTL  Duration `json:"messageTTL"`
+		MaxMessages int      `json:"maxMessages"`
+	}
+
+	ClusterConfig struct {
+		Backend       string   `json:"backend"`
+		NodeId        string   `json:"nodeId"`
+		RedisAddr     string   `json:"redisAddr"`
+		RedisPassword string   `json:"redisPassword"`
+		PeerTTL       Duration `json:"peerTTL"`
+	}
+
+	MeshConfig struct {
+		MaxRenegotiations int `json:"maxRenegotiations"`
+		MaxMembers        int `json:"maxMembers"`
+	}
+
+	ICEServerConfig struct {
+		URLs   []string `json:"urls"`
+		Region string   `json:"region"`
+	}
+
+	ICEConfig struct {
+		Servers       []ICEServerConfig `json:"servers"`
+		TURNSecret    string            `json:"turnSecret"`
+		CredentialTTL Duration          `json:"credentialTTL"`
+	}
+
+	SignalingConfig struct {
+		MaxSDPSize       int  `json:"maxSDPSize"`
+		MaxCandidateSize int  `json:"maxCandidateSize"`
+		AllowLegacy      bool `json:"allowLegacy"`
+	}
+
+	ShutdownConfig struct {
+		Timeout Duration `json:"timeout"`
+	}
+
+	Config struct {
+		Listeners ListenersConfig `json:"listeners"`
+		TLS       TLSConfig       `json:"tls"`
+		Database  DatabaseConfig  `json:"database"`
+		Static    StaticConfig    `json:"static"`
+		GRPC      GRPCConfig      `json:"grpc"`
+		Auth      AuthConfig      `json:"auth"`
+		Outbound  OutboundConfig  `json:"outbound"`
+		Heartbeat HeartbeatConfig `json:"heartbeat"`
+		Resume    ResumeConfig    `json:"resume"`
+		Offline   OfflineConfig   `json:"offline"`
+		Cluster   ClusterConfig   `json:"cluster"`
+		Mesh      MeshConfig      `json:"mesh"`
+		ICE       ICEConfig       `json:"ice"`
+		Signaling SignalingConfig `json:"signaling"`
+		Shutdown  ShutdownConfig  `json:"shutdown"`
+	}
+
+	Duration time.Duration
+
+	configOption struct {
+		flag  string
+		env   string
+		usage string
+		set   func(*Config, string) error
+	}
+
+	configFlagValue struct {
+		value string
+		isSet bool
+	}
+
+	ConfigFlags struct {
+		values map[string]*configFlagValue
+	}
+)
+
+const (
+	MONGO_BACKEND  = "mongo"
+	MEMORY_BACKEND = "memory"
+)
+
+const CONFIG_ENV_PREFIX = "ZIPPYTAL_"
+
+var configOptions = []configOption{
+	stringOption("grpc-addr", "GRPC_ADDR", "gRPC listener address", func(c *Config) *string { return &c.Listeners.GRPC }),
+	stringOption("ws-addr", "WS_ADDR", "websocket listener address", func(c *Config) *string { return &c.Listeners.WS }),
+	stringOption("https-addr", "HTTPS_ADDR", "https listener address, empty to disable", func(c *Config) *string { return &c.Listeners.HTTPS }),
+	boolOption("tls", "TLS_ENABLED", "serve the websocket and https listeners over TLS", func(c *Config) *bool { return &c.TLS.Enabled }),
+	stringOption("tls-app-cert", "TLS_APP_CERT", "certificate chain of the app host", func(c *Config) *string { return &c.TLS.App.CertFile }),
+	stringOption("tls-app-key", "TLS_APP_KEY", "private key of the app host", func(c *Config) *string { return &c.TLS.App.KeyFile }),
+	stringOption("tls-website-cert", "TLS_WEBSITE_CERT", "certificate chain of the website host", func(c *Config) *string { return &c.TLS.Website.CertFile }),
+	stringOption("tls-website-key", "TLS_WEBSITE_KEY", "private key of the website host", func(c *Config) *string { return &c.TLS.Website.KeyFile }),
+	stringOption("db-backend", "DB_BACKEND", "storage backend (mongo or memory)", func(c *Config) *string { return &c.Database.Backend }),
+	stringOption("db-uri", "DB_URI", "mongo connection uri", func(c *Config) *string { return &c.Database.URI }),
+	stringOption("db-name", "DB_NAME", "mongo database name", func(c *Config) *string { return &c.Database.Name }),
+	stringOption("app-host", "APP_HOST", "host name serving the app", func(c *Config) *string { return &c.Static.AppHost }),
+	stringOption("app-dir", "APP_DIR", "directory of the app static assets", func(c *Config) *string { return &c.Static.AppDir }),
+	stringOption("website-host", "WEBSITE_HOST", "host name serving the website, empty to disable", func(c *Config) *string { return &c.Static.WebsiteHost }),
+	stringOption("website-dir", "WEBSITE_DIR", "directory of the website static assets", func(c *Config) *string { return &c.Static.WebsiteDir }),
+	uint32Option("grpc-max-concurrent-streams", "GRPC_MAX_CONCURRENT_STREAMS", "maximum number of concurrent streams per gRPC connection", func(c *Config) *uint32 { return &c.GRPC.MaxConcurrentStreams }),
+	intOption("grpc-max-recv-msg-size", "GRPC_MAX_RECV_MSG_SIZE", "maximum gRPC message size received, 0 for the grpc default", func(c *Config) *int { return &c.GRPC.MaxRecvMsgSize }),
+	intOption("grpc-max-send-msg-size", "GRPC_MAX_SEND_MSG_SIZE", "maximum gRPC message size sent, 0 for the grpc default", func(c *Config) *int { return &c.GRPC.MaxSendMsgSize }),
+	durationOption("grpc-keepalive-time", "GRPC_KEEPALIVE_TIME", "idle time after which the server pings a gRPC connection", func(c *Config) *Duration { return &c.GRPC.KeepaliveTime }),
+	durationOption("grpc-keepalive-timeout", "GRPC_KEEPALIVE_TIMEOUT", "how long the server waits for a keepalive ping answer before closing the connection", func(c *Config) *Duration { return &c.GRPC.KeepaliveTimeout }),
+	durationOption("grpc-keepalive-min-time", "GRPC_KEEPALIVE_MIN_TIME", "minimum interval between client keepalive pings, faster clients are disconnected", func(c *Config) *Duration { return &c.GRPC.KeepaliveMinTime }),
+	durationOption("session-ttl", "SESSION_TTL", "lifetime of an idle session token", func(c *Config) *Duration { return &c.Auth.SessionTTL }),
+	boolOption("persist-sessions", "PERSIST_SESSIONS", "keep session tokens in the database across restarts", func(c *Config) *bool { return &c.Auth.PersistSessions }),
+	stringOption("token-issuer", "TOKEN_ISSUER", "issuer of the signed access tokens", func(c *Config) *string { return &c.Auth.Issuer }),
+	durationOption("access-token-ttl", "ACCESS_TOKEN_TTL", "lifetime of a signed access token", func(c *Config) *Duration { return &c.Auth.AccessTokenTTL }),
+	signingKeysOption("signing-keys", "SIGNING_KEYS", "access token signing keys as id:base64secret separated by commas", func(c *Config) *[]SigningKey { return &c.Auth.SigningKeys }),
+	stringOption("active-signing-key", "ACTIVE_SIGNING_KEY", "id of the key signing new access tokens", func(c *Config) *string { return &c.Auth.ActiveSigningKey }),
+	intOption("outbound-queue-size", "OUTBOUND_QUEUE_SIZE", "number of messages buffered for each connected peer", func(c *Config) *int { return &c.Outbound.QueueSize }),
+	stringOption("outbound-overflow-policy", "OUTBOUND_OVERFLOW_POLICY", "what to do when a peer queue is full (drop_oldest, disconnect or block)", func(c *Config) *string { return &c.Outbound.OverflowPolicy }),
+	durationOption("outbound-block-timeout", "OUTBOUND_BLOCK_TIMEOUT", "how long the block policy waits for room before dropping a message", func(c *Config) *Duration { return &c.Outbound.BlockTimeout }),
+	durationOption("heartbeat-ping-interval", "HEARTBEAT_PING_INTERVAL", "interval between websocket pings and Link heartbeats", func(c *Config) *Duration { return &c.Heartbeat.PingInterval }),
+	durationOption("heartbeat-pong-timeout", "HEARTBEAT_PONG_TIMEOUT", "how long after a missed ping a silent peer is marked asleep", func(c *Config) *Duration { return &c.Heartbeat.PongTimeout }),
+	durationOption("heartbeat-evict-after", "HEARTBEAT_EVICT_AFTER", "how long a silent peer is kept before being evicted", func(c *Config) *Duration { return &c.Heartbeat.EvictAfter }),
+	durationOption("resume-grace-period", "RESUME_GRACE_PERIOD", "how long a disconnected peer can resume its session before leaving its squads", func(c *Config) *Duration { return &c.Resume.GracePeriod }),
+	intOption("resume-buffer-size", "RESUME_BUFFER_SIZE", "number of recent messages kept per peer for replay on resume", func(c *Config) *int { return &c.Resume.BufferSize }),
+	durationOption("offline-message-ttl", "OFFLINE_MESSAGE_TTL", "how long messages for offline peers are kept before being discarded", func(c *Config) *Duration { return &c.Offline.MessageTTL }),
+	intOption("offline-max-messages", "OFFLINE_MAX_MESSAGES", "number of direct messages kept per offline peer", func(c *Config) *int { return &c.Offline.MaxMessages }),
+	stringOption("cluster-backend", "CLUSTER_BACKEND", "bus and directory shared by the manager nodes (none or redis)", func(c *Config) *string { return &c.Cluster.Backend }),
+	stringOption("cluster-node-id", "CLUSTER_NODE_ID", "unique name of this node in the cluster, defaults to the host name", func(c *Config) *string { return &c.Cluster.NodeId }),
+	stringOption("cluster-redis-addr", "CLUSTER_REDIS_ADDR", "host:port of the redis server of the cluster", func(c *Config) *string { return &c.Cluster.RedisAddr }),
+	stringOption("cluster-redis-password", "CLUSTER_REDIS_PASSWORD", "password of the redis server of the cluster", func(c *Config) *string { return &c.Cluster.RedisPassword }),
+	durationOption("cluster-peer-ttl", "CLUSTER_PEER_TTL", "how long a peer stays in the cluster directory without its node refreshing it", func(c *Config) *Duration { return &c.Cluster.PeerTTL }),
+	intOption("mesh-max-renegotiations", "MESH_MAX_RENEGOTIATIONS", "how many times the manager asks a failed mesh pair to renegotiate", func(c *Config) *int { return &c.Mesh.MaxRenegotiations }),
+	intOption("mesh-max-members", "MESH_MAX_MEMBERS", "number of members above which a mesh squad moves to a host, 0 to never move", func(c *Config) *int { return &c.Mesh.MaxMembers }),
+	iceServersOption("ice-servers", "ICE_SERVERS", "ice servers as region@url|url separated by commas, without region for every region", func(c *Config) *[]ICEServerConfig { return &c.ICE.Servers }),
+	stringOption("ice-turn-secret", "ICE_TURN_SECRET", "shared secret used to sign ephemeral turn credentials", func(c *Config) *string { return &c.ICE.TURNSecret }),
+	durationOption("ice-credential-ttl", "ICE_CREDENTIAL_TTL", "how long an ephemeral turn credential stays valid", func(c *Config) *Duration { return &c.ICE.CredentialTTL }),
+	intOption("signaling-max-sdp-size", "SIGNALING_MAX_SDP_SIZE", "maximum size in bytes of the sdp of an offer or an answer", func(c *Config) *int { return &c.Signaling.MaxSDPSize }),
+	intOption("signaling-max-candidate-size", "SIGNALING_MAX_CANDIDATE_SIZE", "maximum size in bytes of an ice candidate", func(c *Config) *int { return &c.Signaling.MaxCandidateSize }),
+	boolOption("signaling-allow-legacy", "SIGNALING_ALLOW_LEGACY", "forward untyped signaling messages without validating them", func(c *Config) *bool { return &c.Signaling.AllowLegacy }),
+	durationOption("shutdown-timeout", "SHUTDOWN_TIMEOUT", "how long a graceful shutdown may take before connections are cut", func(c *Config) *Duration { return &c.Shutdown.Timeout }),
+}
+
+func DefaultConfig() (config *Config) {
+	config = &Config{
+		Listeners: ListenersConfig{
+			GRPC:  ":8080",
+			WS:    ":9999",
+			HTTPS: ":443",
+		},
+		TLS: TLSConfig{
+			Enabled: true,
+			App: CertificateConfig{
+				CertFile: "/etc/letsencrypt/live/app.zippytal.com/fullchain.pem",
+				KeyFile:  "/etc/letsencrypt/live/app.zippytal.com/privkey.pem",
+			},
+			Website: CertificateConfig{
+				CertFile: "/etc/letsencrypt/live/zippytal.com/fullchain.pem",
+				KeyFile:  "/etc/letsencrypt/live/zippytal.com/privkey.pem",
+			},
+		},
+		Database: DatabaseConfig{
+			Backend: MONGO_BACKEND,
+			URI:     "mongodb://localhost:27017",
+			Name:    DB_NAME,
+		},
+		Static: StaticConfig{
+			AppHost:     "app.zippytal.com",
+			AppDir:      "./app",
+			WebsiteHost: "zippytal.com",
+			WebsiteDir:  "./website",
+		},
+		GRPC: GRPCConfig{
+			MaxConcurrentStreams: 100000,
+			KeepaliveTime:        Duration(DEFAULT_GRPC_KEEPALIVE_TIME),
+			KeepaliveTimeout:     Duration(DEFAULT_GRPC_KEEPALIVE_WAIT),
+			KeepaliveMinTime:     Duration(DEFAULT_GRPC_KEEPALIVE_MIN),
+		},
+		Auth: AuthConfig{
+			SessionTTL:      Duration(DEFAULT_SESSION_TTL),
+			PersistSessions: true,
+			Issuer:          "zippytal_server",
+			AccessTokenTTL:  Duration(DEFAULT_ACCESS_TOKEN_TTL),
+		},
+		Outbound: OutboundConfig{
+			QueueSize:      DEFAULT_OUTBOUND_QUEUE_SIZE,
+			OverflowPolicy: string(DROP_OLDEST),
+			BlockTimeout:   Duration(DEFAULT_OUTBOUND_BLOCK_TIMEOUT),
+		},
+		Heartbeat: HeartbeatConfig{
+			PingInterval: Duration(DEFAULT_PING_INTERVAL),
+			PongTimeout:  Duration(DEFAULT_PONG_TIMEOUT),
+			EvictAfter:   Duration(DEFAULT_EVICT_AFTER),
+		},
+		Resume: ResumeConfig{
+			GracePeriod: Duration(DEFAULT_RESUME_GRACE_PERIOD),
+			BufferSize:  DEFAULT_RESUME_BUFFER_SIZE,
+		},
+		Offline: OfflineConfig{
+			MessageTTL:  Duration(DEFAULT_OFFLINE_MESSAGE_TTL),
+			MaxMessages: DEFAULT_OFFLINE_MAX_MESSAGES,
+		},
+		Cluster: ClusterConfig{
+			Backend:   NO_CLUSTER,
+			RedisAddr: "localhost:6379",
+			PeerTTL:   Duration(DEFAULT_CLUSTER_PEER_TTL),
+		},
+		Mesh: MeshConfig{
+			MaxRenegotiations: DEFAULT_MESH_MAX_RENEGOTIATIONS,
+			MaxMembers:        DEFAULT_MESH_MAX_MEMBERS,
+		},
+		ICE: ICEConfig{
+			Servers:       []ICEServerConfig{},
+			CredentialTTL: Duration(DEFAULT_TURN_CREDENTIAL_TTL),
+		},
+		Signaling: SignalingConfig{
+			MaxSDPSize:       DEFAULT_MAX_SDP_SIZE,
+			MaxCandidateSize: DEFAULT_MAX_CANDIDATE_SIZE,
+			AllowLegacy:      true,
+		},
+		Shutdown: ShutdownConfig{
+			Timeout: Duration(DEFAULT_SHUTDOWN_TIMEOUT),
+		},
+	}
+	return
+}
+
+func LoadConfig(path string) (config *Config, err error) {
+	config = DefaultConfig()
+	if path == "" {
+		return
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		err = fmt.Errorf("cannot open config file : %v", err)
+		return
+	}
+	defer file.Close()
+	decoder := json.NewDecoder(file)
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(config); err != nil {
+		err = fmt.Errorf("cannot decode config file %s : %v", path, err)
+	}
+	return
+}
+
+func (config *Config) ApplyEnv(lookup func(string) (string, bool)) (err error) {
+	for _, option := range configOptions {
+		if value, ok := lookup(CONFIG_ENV_PREFIX + option.env); ok {
+			if err = option.set(config, value); err != nil {
+				err = fmt.Errorf("environment variable %s%s : %v", CONFIG_ENV_PREFIX, option.env, err)
+				return
+			}
+		}
+	}
+	return
+}
+
+func NewConfigFlags(flagSet *flag.FlagSet) (configFlags *ConfigFlags) {
+	configFlags = &ConfigFlags{
+		values: make(map[string]*configFlagValue),
+	}
+	for _, option := range configOptions {
+		value := &configFlagValue{}
+		configFlags.values[option.flag] = value
+		flagSet.Var(value, option.flag, option.usage)
+	}
+	return
+}
+
+func (configFlags *ConfigFlags) Apply(config *Config) (err error) {
+	for _, option := range configOptions {
+		if value := configFlags.values[option.flag]; value.isSet {
+			if err = option.set(config, value.value); err != nil {
+				err = fmt.Errorf("flag -%s : %v", option.flag, err)
+				return
+			}
+		}
+	}
+	return
+}
+
+func (config *Config) Validate() (err error) {
+	errs := make([]string, 0)
+	addrs := make(map[string]string)
+	for _, listener := range []struct {
+		name     string
+		addr     string
+		required bool
+	}{
+		{"listeners.grpc", config.Listeners.GRPC, true},
+		{"listeners.ws", config.Listeners.WS, true},
+		{"listeners.https", config.Listeners.HTTPS, false},
+	} {
+		if listener.addr == "" {
+			if listener.required {
+				errs = append(errs, fmt.Sprintf("%s is required", listener.name))
+			}
+			continue
+		}
+		if _, port, e := net.SplitHostPort(listener.addr); e != nil {
+			errs = append(errs, fmt.Sprintf("%s %q is not a valid host:port address", listener.name, listener.addr))
+			continue
+		} else if p, e := strconv.Atoi(port); e != nil || p < 0 || p > 65535 {
+			errs = append(errs, fmt.Sprintf("%s %q has an invalid port", listener.name, listener.addr))
+			continue
+		}
+		if other, ok := addrs[listener.addr]; ok {
+			errs = append(errs, fmt.Sprintf("%s and %s both listen on %s", other, listener.name, listener.addr))
+		}
+		addrs[listener.addr] = listener.name
+	}
+	if config.TLS.Enabled {
+		errs = append(errs, config.TLS.App.validate("tls.app", true)...)
+		errs = append(errs, config.TLS.Website.validate("tls.website", false)...)
+	}
+	switch config.Database.Backend {
+	case MONGO_BACKEND:
+		if !strings.HasPrefix(config.Database.URI, "mongodb://") && !strings.HasPrefix(config.Database.URI, "mongodb+srv://") {
+			errs = append(errs, fmt.Sprintf("database.uri %q must start with mongodb:// or mongodb+srv://", config.Database.URI))
+		}
+		if config.Database.Name == "" {
+			errs = append(errs, "database.name is required")
+		} else if strings.ContainsAny(config.Database.Name, "/\\. \"$") {
+			errs = append(errs, fmt.Sprintf("database.name %q contains a forbidden character", config.Database.Name))
+		}
+	case MEMORY_BACKEND:
+	default:
+		errs = append(errs, fmt.Sprintf("database.backend %q must be %s or %s", config.Database.Backend, MONGO_BACKEND, MEMORY_BACKEND))
+	}
+	if config.Static.AppDir == "" {
+		errs = append(errs, "static.appDir is required")
+	}
+	if config.Static.WebsiteHost != "" && config.Static.WebsiteDir == "" {
+		errs = append(errs, "static.websiteDir is required when static.websiteHost is set")
+	}
+	if config.GRPC.MaxConcurrentStreams == 0 {
+		errs = append(errs, "grpc.maxConcurrentStreams must be greater than 0")
+	}
+	if config.GRPC.MaxRecvMsgSize < 0 {
+		errs = append(errs, "grpc.maxRecvMsgSize must not be negative")
+	}
+	if config.GRPC.MaxSendMsgSize < 0 {
+		errs = append(errs, "grpc.maxSendMsgSize must not be negative")
+	}
+	for _, keepalive := range []struct {
+		name  string
+		value Duration
+	}{
+		{"grpc.keepaliveTime", config.GRPC.KeepaliveTime},
+		{"grpc.keepaliveTimeout", config.GRPC.KeepaliveTimeout},
+		{"grpc.keepaliveMinTime", config.GRPC.KeepaliveMinTime},
+	} {
+		if keepalive.value <= 0 {
+			errs = append(errs, fmt.Sprintf("%s must be a positive duration", keepalive.name))
+		}
+	}
+	if config.Auth.SessionTTL <= 0 {
+		errs = append(errs, "auth.sessionTTL must be a positive duration")
+	}
+	errs = append(errs, config.Auth.validateSigningKeys()...)
+	if config.Outbound.QueueSize <= 0 {
+		errs = append(errs, "outbound.queueSize must be greater than 0")
+	}
+	switch OverflowPolicy(config.Outbound.OverflowPolicy) {
+	case DROP_OLDEST, DISCONNECT:
+	case BLOCK:
+		if config.Outbound.BlockTimeout <= 0 {
+			errs = append(errs, "outbound.blockTimeout must be a positive duration with the block policy")
+		}
+	default:
+		errs = append(errs, fmt.Sprintf("outbound.overflowPolicy %q must be %s, %s or %s", config.Outbound.OverflowPolicy, DROP_OLDEST, DISCONNECT, BLOCK))
+	}
+	if config.Heartbeat.PingInterval <= 0 || config.Heartbeat.PongTimeout <= 0 {
+		errs = append(errs, "heartbeat.pingInterval and heartbeat.pongTimeout must be positive durations")
+	} else if time.Duration(config.Heartbeat.EvictAfter) <= config.Heartbeat.sleepAfter() {
+		errs = append(errs, "heartbeat.evictAfter must be longer than heartbeat.pingInterval plus heartbeat.pongTimeout")
+	}
+	if config.Resume.GracePeriod < 0 {
+		errs = append(errs, "resume.gracePeriod must not be negative")
+	}
+	if config.Resume.BufferSize <= 0 {
+		errs = append(errs, "resume.bufferSize must be greater than 0")
+	}
+	if config.Offline.MessageTTL <= 0 {
+		errs = append(errs, "offline.messageTTL must be a positive duration")
+	}
+	if config.Offline.MaxMessages <= 0 {
+		errs = append(errs, "offline.maxMessages must be greater than 0")
+	}
+	switch config.Cluster.Backend {
+	case NO_CLUSTER:
+	case REDIS_CLUSTER:
+		if _, _, e := net.SplitHostPort(config.Cluster.RedisAddr); e != nil {
+			errs = append(errs, fmt.Sprintf("cluster.redisAddr %q is not a valid host:port address", config.Cluster.RedisAddr))
+		}
+		if config.Cluster.PeerTTL < Duration(time.Second) {
+			errs = append(errs, "cluster.peerTTL must be at least 1s")
+		}
+	default:
+		errs = append(errs, fmt.Sprintf("cluster.backend %q must be %s or %s", config.Cluster.Backend, NO_CLUSTER, REDIS_CLUSTER))
+	}
+	if config.Mesh.MaxRenegotiations < 0 || config.Mesh.MaxMembers < 0 {
+		errs = append(errs, "mesh.maxRenegotiations and mesh.maxMembers must not be negative")
+	}
+	errs = append(errs, config.ICE.validate()...)
+	if config.Signaling.MaxSDPSize <= 0 || config.Signaling.MaxCandidateSize <= 0 {
+		errs = append(errs, "signaling.maxSDPSize and signaling.maxCandidateSize must be greater than 0")
+	}
+	if config.Shutdown.Timeout <= 0 {
+		errs = append(errs, "shutdown.timeout must be a positive duration")
+	}
+	if len(errs) > 0 {
+		err = fmt.Errorf("invalid configuration : %s", strings.Join(errs, "; "))
+	}
+	return
+}
+
+func (ice ICEConfig) validate() (errs []string) {
+	if ice.CredentialTTL <= 0 {
+		errs = append(errs, "ice.credentialTTL must be a positive duration")
+	}
+	for _, server := range ice.Servers {
+		if len(server.URLs) == 0 {
+			errs = append(errs, "ice.servers entries must have at least one url")
+		}
+		for _, url := range server.URLs {
+			switch {
+			case isTURNURL(url):
+				if ice.TURNSecret == "" {
+					errs = append(errs, fmt.Sprintf("ice.turnSecret is required by the turn server %q", url))
+				}
+			case strings.HasPrefix(url, "stun:") || strings.HasPrefix(url, "stuns:"):
+			default:
+				errs = append(errs, fmt.Sprintf("ice server url %q must start with stun:, stuns:, turn: or turns:", url))
+			}
+		}
+	}
+	return
+}
+
+func (certificate CertificateConfig) validate(name string, required bool) (errs []string) {
+	if certificate.CertFile == "" && certificate.KeyFile == "" {
+		if required {
+			errs = append(errs, fmt.Sprintf("%s.certFile and %s.keyFile are required when tls is enabled", name, name))
+		}
+		return
+	}
+	for _, file := range [][2]string{{"certFile", certificate.CertFile}, {"keyFile", certificate.KeyFile}} {
+		if file[1] == "" {
+			errs = append(errs, fmt.Sprintf("%s.%s is required", name, file[0]))
+		} else if _, err := os.Stat(file[1]); err != nil {
+			errs = append(errs, fmt.Sprintf("%s.%s : %v", name, file[0], err))
+		}
+	}
+	return
+}
+
+func (auth AuthConfig) validateSigningKeys() (errs []string) {
+	if len(auth.SigningKeys) == 0 {
+		if auth.ActiveSigningKey != "" {
+			errs = append(errs, fmt.Sprintf("auth.activeSigningKey %q is set but auth.signingKeys is empty", auth.ActiveSigningKey))
+		}
+		return
+	}
+	if auth.Issuer == "" {
+		errs = append(errs, "auth.issuer is required when auth.signingKeys is set")
+	}
+	if auth.AccessTokenTTL <= 0 {
+		errs = append(errs, "auth.accessTokenTTL must be a positive duration")
+	}
+	ids := make(map[string]bool)
+	for i, key := range auth.SigningKeys {
+		if key.ID == "" {
+			errs = append(errs, fmt.Sprintf("auth.signingKeys[%d].id is required", i))
+		} else if ids[key.ID] {
+			errs = append(errs, fmt.Sprintf("auth.signingKeys[%d].id %q is duplicated", i, key.ID))
+		}
+		ids[key.ID] = true
+		if secret, err := base64.StdEncoding.DecodeString(key.Secret); err != nil {
+			errs = append(errs, fmt.Sprintf("auth.signingKeys[%d].secret is not valid base64", i))
+		} else if len(secret) < MIN_SIGNING_KEY_SIZE {
+			errs = append(errs, fmt.Sprintf("auth.signingKeys[%d].secret must decode to at least %d bytes", i, MIN_SIGNING_KEY_SIZE))
+		}
+	}
+	if !ids[auth.ActiveSigningKey] {
+		errs = append(errs, fmt.Sprintf("auth.activeSigningKey %q is not one of auth.signingKeys", auth.ActiveSigningKey))
+	}
+	return
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) (err error) {
+	var s string
+	if err = json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("a duration must be a string like \"1h30m\"")
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return
+	}
+	*d = Duration(duration)
+	return
+}
+
+func (value *configFlagValue) String() string {
+	return value.value
+}
+
+func (value *configFlagValue) Set(v string) error {
+	value.value, value.isSet = v, true
+	return nil
+}
+
+func stringOption(flag string, env string, usage string, field func(*Config) *string) configOption {
+	return configOption{flag: flag, env: env, usage: usage, set: func(c *Config, v string) error {
+		*field(c) = v
+		return nil
+	}}
+}
+
+func boolOption(flag string, env string, usage string, field func(*Config) *bool) configOption {
+	return configOption{flag: flag, env: env, usage: usage, set: func(c *Config, v string) (err error) {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("%q is not a boolean", v)
+		}
+		*field(c) = b
+		return
+	}}
+}
+
+func intOption(flag string, env string, usage string, field func(*Config) *int) configOption {
+	return configOption{flag: flag, env: env, usage: usage, set: func(c *Config, v string) (err error) {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", v)
+		}
+		*field(c) = i
+		return
+	}}
+}
+
+func uint32Option(flag string, env string, usage string, field func(*Config) *uint32) configOption {
+	return configOption{flag: flag, env: env, usage: usage, set: func(c *Config, v string) (err error) {
+		i, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("%q is not a positive integer", v)
+		}
+		*field(c) = uint32(i)
+		return
+	}}
+}
+
+func durationOption(flag string, env string, usage string, field func(*Config) *Duration) configOption {
+	return configOption{flag: flag, env: env, usage: usage, set: func(c *Config, v string) (err error) {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration", v)
+		}
+		*field(c) = Duration(d)
+		return
+	}}
+}
+
+func signingKeysOption(flag string, env string, usage string, field func(*Config) *[]SigningKey) configOption {
+	return configOption{flag: flag, env: env, usage: usage, set: func(c *Config, v string) (err error) {
+		keys := make([]SigningKey, 0)
+		for _, entry := range strings.Split(v, ",") {
+			if entry = strings.TrimSpace(entry); entry == "" {
+				continue
+			}
+			parts := strings.SplitN(entry, ":", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("signing key %q must be formatted as id:base64secret", entry)
+			}
+			keys = append(keys, SigningKey{ID: parts[0], Secret: parts[1]})
+		}
+		*field(c) = keys
+		return
+	}}
+}
+
+func iceServersOption(flag string, env string, usage string, field func(*Config) *[]ICEServerConfig) configOption {
+	return configOption{flag: flag, env: env, usage: usage, set: func(c *Config, v string) (err error) {
+		servers := make([]ICEServerConfig, 0)
+		for _, entry := range strings.Split(v, ",") {
+			if entry = strings.TrimSpace(entry); entry == "" {
+				continue
+			}
+			server := ICEServerConfig{}
+			if i := strings.Index(entry, "@"); i >= 0 {
+				server.Region, entry = entry[:i], entry[i+1:]
+			}
+			for _, url := range strings.Split(entry, "|") {
+				if url = strings.TrimSpace(url); url != "" {
+					server.URLs = append(server.URLs, url)
+				}
+			}
+			servers = append(servers, server)
+		}
+		*field(c) = servers
+		return
+	}}
+}
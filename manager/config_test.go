@@ -0,0 +1,76 @@
+package manager
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConfigPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"listeners":{"grpc":":7000","ws":":7001"},"database":{"backend":"memory"}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := map[string]string{
+		CONFIG_ENV_PREFIX + "WS_ADDR":         ":7002",
+		CONFIG_ENV_PREFIX + "GRPC_ADDR":       ":7003",
+		CONFIG_ENV_PREFIX + "TLS_ENABLED":     "false",
+		CONFIG_ENV_PREFIX + "ICE_SERVERS":     "stun:stun.zippytal.com:3478,eu@stun:eu.zippytal.com:3478|turn:eu.zippytal.com:3478?transport=udp",
+		CONFIG_ENV_PREFIX + "ICE_TURN_SECRET": "secret",
+	}
+	if err = config.ApplyEnv(func(key string) (value string, ok bool) {
+		value, ok = env[key]
+		return
+	}); err != nil {
+		t.Fatal(err)
+	}
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	configFlags := NewConfigFlags(flagSet)
+	if err = flagSet.Parse([]string{"-grpc-addr", ":7004"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = configFlags.Apply(config); err != nil {
+		t.Fatal(err)
+	}
+	if config.Listeners.GRPC != ":7004" || config.Listeners.WS != ":7002" || config.Listeners.HTTPS != ":443" {
+		t.Errorf("unexpected listeners %+v", config.Listeners)
+	}
+	if config.Database.Backend != MEMORY_BACKEND || config.Database.Name != DB_NAME {
+		t.Errorf("unexpected database %+v", config.Database)
+	}
+	if servers := config.ICE.Servers; len(servers) != 2 || servers[0].Region != "" || servers[1].Region != "eu" || len(servers[1].URLs) != 2 {
+		t.Errorf("unexpected ice servers %+v", servers)
+	}
+	if err = config.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	config := DefaultConfig()
+	config.Listeners.GRPC = "8080"
+	config.Listeners.HTTPS = config.Listeners.WS
+	config.TLS.App.CertFile = filepath.Join(t.TempDir(), "missing.pem")
+	config.Database.URI = "localhost:27017"
+	config.GRPC.MaxConcurrentStreams = 0
+	config.Outbound.OverflowPolicy = "ignore"
+	config.ICE.Servers = []ICEServerConfig{{URLs: []string{"turn:turn.zippytal.com:3478"}}}
+	err := config.Validate()
+	if err == nil {
+		t.Fatal("expected an invalid configuration")
+	}
+	for _, expected := range []string{"listeners.grpc", "listeners.https", "tls.app.certFile", "database.uri", "grpc.maxConcurrentStreams", "outbound.overflowPolicy", "ice.turnSecret"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected %s in %v", expected, err)
+		}
+	}
+	if _, err = LoadConfig(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected an error for a missing config file")
+	}
+}
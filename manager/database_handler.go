@@ -14,10 +14,10 @@ type DbManager struct {
 	Db *mongo.Database
 }
 
-func NewDbManager(ctx context.Context, dbName string, host string, port int) (<-chan *DbManager, <-chan error) {
+func NewDbManager(ctx context.Context, dbName string, uri string) (<-chan *DbManager, <-chan error) {
 	dbManagerChan, errChan := make(chan *DbManager), make(chan error)
 	go func() {
-		client, err := mongo.NewClient(options.Client().ApplyURI(fmt.Sprintf("mongodb://%s:%d", host, port)))
+		client, err := mongo.NewClient(options.Client().ApplyURI(uri))
 		if err != nil {
 			errChan <- err
 			return
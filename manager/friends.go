@@ -0,0 +1,331 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FRIEND_REQUEST           SquadEvent = "friend_request"
+	FRIEND_REQUEST_ACCEPTED  SquadEvent = "friend_request_accepted"
+	FRIEND_REQUEST_DECLINED  SquadEvent = "friend_request_declined"
+	FRIEND_REQUEST_CANCELLED SquadEvent = "friend_request_cancelled"
+	FRIEND_REMOVED           SquadEvent = "friend_removed"
+)
+
+type BlockList struct {
+	blocked map[string]map[string]bool
+	*sync.RWMutex
+}
+
+func NewBlockList() *BlockList {
+	return &BlockList{
+		blocked: make(map[string]map[string]bool),
+		RWMutex: &sync.RWMutex{},
+	}
+}
+
+func (bl *BlockList) load(peer *Peer) {
+	bl.Lock()
+	defer bl.Unlock()
+	blocked := make(map[string]bool, len(peer.Blocked))
+	for _, peerId := range peer.Blocked {
+		blocked[peerId] = true
+	}
+	bl.blocked[peer.Id] = blocked
+}
+
+func (bl *BlockList) forget(peerId string) {
+	bl.Lock()
+	defer bl.Unlock()
+	delete(bl.blocked, peerId)
+}
+
+func (bl *BlockList) lookup(blocker string, peerId string) (blocked bool, loaded bool) {
+	bl.RLock()
+	defer bl.RUnlock()
+	list, loaded := bl.blocked[blocker]
+	blocked = list[peerId]
+	return
+}
+
+func (request *FriendRequest) payload() map[string]string {
+	return map[string]string{
+		"requestId": request.Id,
+		"from":      request.From,
+		"to":        request.To,
+		"createdAt": fmt.Sprint(request.CreatedAt),
+	}
+}
+
+func containsPeer(peers []string, peerId string) bool {
+	for _, p := range peers {
+		if p == peerId {
+			return true
+		}
+	}
+	return false
+}
+
+func withoutPeer(peers []string, peerId string) (filtered []string) {
+	filtered = make([]string, 0, len(peers))
+	for _, p := range peers {
+		if p != peerId {
+			filtered = append(filtered, p)
+		}
+	}
+	return
+}
+
+func (manager *Manager) isBlocked(blocker string, peerId string) bool {
+	if blocked, loaded := manager.Blocks.lookup(blocker, peerId); loaded {
+		return blocked
+	}
+	peer, err := manager.PeerStore.GetPeer(context.Background(), blocker)
+	if err != nil {
+		return false
+	}
+	manager.Blocks.load(peer)
+	return containsPeer(peer.Blocked, peerId)
+}
+
+func (manager *Manager) SendFriendRequest(token string, from string, to string) (request *FriendRequest, err error) {
+	if err = manager.authenticate(token, from); err != nil {
+		return
+	}
+	if from == to {
+		err = fmt.Errorf("you cannot befriend yourself")
+		return
+	}
+	peer, err := manager.PeerStore.GetPeer(context.Background(), from)
+	if err != nil {
+		return
+	}
+	if _, err = manager.PeerStore.GetPeer(context.Background(), to); err != nil {
+		err = fmt.Errorf("no peer with id %s", to)
+		return
+	}
+	if containsPeer(peer.Friends, to) {
+		err = fmt.Errorf("you are already friend with %s", to)
+		return
+	}
+	if containsPeer(peer.Blocked, to) || manager.isBlocked(to, from) {
+		err = fmt.Errorf("cannot send a friend request to %s", to)
+		return
+	}
+	pending, err := manager.PeerStore.GetFriendRequests(context.Background(), from)
+	if err != nil {
+		return
+	}
+	for _, p := range pending {
+		if p.From == from && p.To == to {
+			err = fmt.Errorf("you already sent a friend request to %s", to)
+			return
+		}
+		if p.From == to && p.To == from {
+			err = fmt.Errorf("%s already sent you a friend request", to)
+			return
+		}
+	}
+	request = &FriendRequest{
+		Id:        uuid.NewString(),
+		From:      from,
+		To:        to,
+		CreatedAt: time.Now().Unix(),
+	}
+	if err = manager.PeerStore.AddFriendRequest(context.Background(), request); err != nil {
+		request = nil
+		return
+	}
+	manager.notifySquad([]string{to}, from, FRIEND_REQUEST, request.payload())
+	return
+}
+
+func (manager *Manager) friendRequest(token string, from string, requestId string) (request *FriendRequest, err error) {
+	if err = manager.authenticate(token, from); err != nil {
+		return
+	}
+	request, err = manager.PeerStore.GetFriendRequest(context.Background(), requestId)
+	if err != nil || (request.From != from && request.To != from) {
+		request, err = nil, fmt.Errorf("no friend request %s", requestId)
+	}
+	return
+}
+
+func (manager *Manager) AcceptFriendRequest(token string, from string, requestId string) (request *FriendRequest, err error) {
+	if request, err = manager.friendRequest(token, from, requestId); err != nil {
+		return
+	}
+	if request.To != from {
+		err = fmt.Errorf("only %s can accept this friend request", request.To)
+		return
+	}
+	if err = manager.PeerStore.DeleteFriendRequest(context.Background(), requestId); err != nil {
+		return
+	}
+	for _, pair := range [][2]string{{request.From, request.To}, {request.To, request.From}} {
+		peer, e := manager.PeerStore.GetPeer(context.Background(), pair[0])
+		if e != nil {
+			err = e
+			return
+		}
+		if containsPeer(peer.Friends, pair[1]) {
+			continue
+		}
+		if err = manager.PeerStore.UpdatePeerFriends(context.Background(), pair[0], append(peer.Friends, pair[1])); err != nil {
+			return
+		}
+	}
+	manager.notifySquad([]string{request.From}, from, FRIEND_REQUEST_ACCEPTED, request.payload())
+	return
+}
+
+func (manager *Manager) DeclineFriendRequest(token string, from string, requestId string) (request *FriendRequest, err error) {
+	if request, err = manager.friendRequest(token, from, requestId); err != nil {
+		return
+	}
+	if request.To != from {
+		err = fmt.Errorf("only %s can decline this friend request", request.To)
+		return
+	}
+	if err = manager.PeerStore.DeleteFriendRequest(context.Background(), requestId); err != nil {
+		return
+	}
+	manager.notifySquad([]string{request.From}, from, FRIEND_REQUEST_DECLINED, request.payload())
+	return
+}
+
+func (manager *Manager) CancelFriendRequest(token string, from string, requestId string) (request *FriendRequest, err error) {
+	if request, err = manager.friendRequest(token, from, requestId); err != nil {
+		return
+	}
+	if request.From != from {
+		err = fmt.Errorf("only %s can cancel this friend request", request.From)
+		return
+	}
+	if err = manager.PeerStore.DeleteFriendRequest(context.Background(), requestId); err != nil {
+		return
+	}
+	manager.notifySquad([]string{request.To}, from, FRIEND_REQUEST_CANCELLED, request.payload())
+	return
+}
+
+func (manager *Manager) contactsChanged(peerId string) {
+	manager.SignalGrants.revokePeer(peerId)
+	manager.revalidatePresence(peerId)
+	manager.broadcast(CONTACTS_CHANGED, peerId, nil)
+}
+
+func (manager *Manager) unfriend(from string, peerId string) (removed bool, err error) {
+	for _, pair := range [][2]string{{from, peerId}, {peerId, from}} {
+		peer, e := manager.PeerStore.GetPeer(context.Background(), pair[0])
+		if e != nil {
+			err = e
+			return
+		}
+		if !containsPeer(peer.Friends, pair[1]) {
+			continue
+		}
+		removed = true
+		if err = manager.PeerStore.UpdatePeerFriends(context.Background(), pair[0], withoutPeer(peer.Friends, pair[1])); err != nil {
+			return
+		}
+		manager.contactsChanged(pair[0])
+	}
+	return
+}
+
+func (manager *Manager) RemoveFriend(token string, from string, peerId string) (err error) {
+	if err = manager.authenticate(token, from); err != nil {
+		return
+	}
+	removed, err := manager.unfriend(from, peerId)
+	if err != nil {
+		return
+	}
+	if !removed {
+		err = fmt.Errorf("you are not friend with %s", peerId)
+		return
+	}
+	manager.notifySquad([]string{peerId}, from, FRIEND_REMOVED, map[string]string{"id": from})
+	return
+}
+
+func (manager *Manager) BlockPeer(token string, from string, peerId string) (err error) {
+	if err = manager.authenticate(token, from); err != nil {
+		return
+	}
+	if from == peerId {
+		err = fmt.Errorf("you cannot block yourself")
+		return
+	}
+	peer, err := manager.PeerStore.GetPeer(context.Background(), from)
+	if err != nil {
+		return
+	}
+	if !containsPeer(peer.Blocked, peerId) {
+		peer.Blocked = append(peer.Blocked, peerId)
+		if err = manager.PeerStore.UpdatePeerBlocked(context.Background(), from, peer.Blocked); err != nil {
+			return
+		}
+	}
+	manager.Blocks.load(peer)
+	manager.contactsChanged(from)
+	removed, err := manager.unfriend(from, peerId)
+	if err != nil {
+		return
+	}
+	requests, err := manager.PeerStore.GetFriendRequests(context.Background(), from)
+	if err != nil {
+		return
+	}
+	for _, request := range requests {
+		if request.From == peerId || request.To == peerId {
+			if err = manager.PeerStore.DeleteFriendRequest(context.Background(), request.Id); err != nil {
+				return
+			}
+		}
+	}
+	if removed {
+		manager.notifySquad([]string{peerId}, from, FRIEND_REMOVED, map[string]string{"id": from})
+	}
+	return
+}
+
+func (manager *Manager) UnblockPeer(token string, from string, peerId string) (err error) {
+	if err = manager.authenticate(token, from); err != nil {
+		return
+	}
+	peer, err := manager.PeerStore.GetPeer(context.Background(), from)
+	if err != nil {
+		return
+	}
+	if !containsPeer(peer.Blocked, peerId) {
+		err = fmt.Errorf("peer %s is not blocked", peerId)
+		return
+	}
+	peer.Blocked = withoutPeer(peer.Blocked, peerId)
+	if err = manager.PeerStore.UpdatePeerBlocked(context.Background(), from, peer.Blocked); err != nil {
+		return
+	}
+	manager.Blocks.load(peer)
+	manager.contactsChanged(from)
+	return
+}
+
+func (manager *Manager) ListFriends(token string, from string) (friends []string, blocked []string, requests []*FriendRequest, err error) {
+	if err = manager.authenticate(token, from); err != nil {
+		return
+	}
+	peer, err := manager.PeerStore.GetPeer(context.Background(), from)
+	if err != nil {
+		return
+	}
+	friends, blocked = peer.Friends, peer.Blocked
+	requests, err = manager.PeerStore.GetFriendRequests(context.Background(), from)
+	return
+}
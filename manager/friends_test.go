@@ -0,0 +1,137 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func befriendTestPeers(t *testing.T, m *Manager, peerId string, other string) {
+	t.Helper()
+	for _, pair := range [][2]string{{peerId, other}, {other, peerId}} {
+		peer, err := m.PeerStore.GetPeer(context.Background(), pair[0])
+		if err != nil {
+			peer = &Peer{Id: pair[0], Name: pair[0]}
+			if err = m.PeerStore.AddNewPeer(context.Background(), peer); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err = m.PeerStore.UpdatePeerFriends(context.Background(), pair[0], append(peer.Friends, pair[1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFriendRequests(t *testing.T) {
+	m := NewMemoryManager()
+	tokens := map[string]string{}
+	for _, peer := range []string{"lolo", "lolo2", "lolo3"} {
+		if err := m.PeerStore.AddNewPeer(context.Background(), &Peer{Id: peer, Name: peer}); err != nil {
+			t.Fatal(err)
+		}
+		tokens[peer] = newTestSession(t, m, peer)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &testLinkStream{ctx: ctx, sent: make(chan *Response, 16)}
+	go m.AddGrpcPeer(stream, "lolo2", &Request{})
+	for i := 0; i < 100 && !m.Router.Connected("lolo2"); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	request, err := m.SendFriendRequest(tokens["lolo"], "lolo", "lolo2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case res := <-stream.sent:
+		if res.Type != string(FRIEND_REQUEST) || res.Payload["requestId"] != request.Id {
+			t.Errorf("expected a friend request, got %v", res)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("lolo2 was not notified of the friend request")
+	}
+	if _, err = m.SendFriendRequest(tokens["lolo2"], "lolo2", "lolo"); err == nil {
+		t.Error("expected a crossed friend request to be rejected")
+	}
+	if _, err = m.AcceptFriendRequest(tokens["lolo"], "lolo", request.Id); err == nil {
+		t.Error("expected the sender to be unable to accept its own request")
+	}
+	if _, err = m.AcceptFriendRequest(tokens["lolo2"], "lolo2", request.Id); err != nil {
+		t.Fatal(err)
+	}
+	friends, _, requests, err := m.ListFriends(tokens["lolo"], "lolo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(friends) != 1 || friends[0] != "lolo2" || len(requests) != 0 {
+		t.Errorf("expected lolo2 to be lolo's only friend, got %v %v", friends, requests)
+	}
+	cancelled, err := m.SendFriendRequest(tokens["lolo3"], "lolo3", "lolo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.CancelFriendRequest(tokens["lolo3"], "lolo3", cancelled.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.SendFriendRequest(tokens["lolo3"], "lolo3", "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.BlockPeer(tokens["lolo"], "lolo", "lolo3"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.SendFriendRequest(tokens["lolo3"], "lolo3", "lolo"); err == nil {
+		t.Error("expected a blocked peer to be unable to send a friend request")
+	}
+	if err = m.RemoveFriend(tokens["lolo2"], "lolo2", "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	friends, blocked, requests, err := m.ListFriends(tokens["lolo"], "lolo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(friends) != 0 || len(blocked) != 1 || len(requests) != 0 {
+		t.Errorf("unexpected contacts %v %v %v", friends, blocked, requests)
+	}
+}
+
+func TestBlockedSignaling(t *testing.T) {
+	m := NewMemoryManager()
+	url := newTestWSServer(t, m)
+	tokens := map[string]string{}
+	for _, peer := range []string{"lolo", "lolo2", "lolo3"} {
+		if err := m.PeerStore.AddNewPeer(context.Background(), &Peer{Id: peer, Name: peer}); err != nil {
+			t.Fatal(err)
+		}
+		tokens[peer] = newTestSession(t, m, peer)
+	}
+	befriendTestPeers(t, m, "lolo", "lolo2")
+	befriendTestPeers(t, m, "lolo", "lolo3")
+	if err := m.BlockPeer(tokens["lolo"], "lolo", "lolo3"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &testLinkStream{ctx: ctx, sent: make(chan *Response, 16)}
+	go m.AddGrpcPeer(stream, "lolo", &Request{})
+	for i := 0; i < 100 && !m.Router.Connected("lolo"); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	for _, peer := range []string{"lolo3", "lolo2"} {
+		conn := dialTestWS(t, url+"?token="+tokens[peer])
+		if err := conn.WriteJSON(&ServRequest{Type: WS_INIT}); err != nil {
+			t.Fatal(err)
+		}
+		if err := conn.WriteJSON(&ServRequest{Type: "offer", To: "lolo", Payload: map[string]string{"sdp": peer}}); err != nil {
+			t.Fatal(err)
+		}
+		waitForWSPeer(t, m, peer)
+	}
+	select {
+	case res := <-stream.sent:
+		if res.Payload["from"] != "lolo2" {
+			t.Errorf("expected the first offer to come from lolo2, got %v", res)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("lolo never received lolo2's offer")
+	}
+}
@@ -0,0 +1,97 @@
+package manager
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	identityContextKey struct{}
+
+	tokenRequest interface {
+		GetToken() string
+	}
+
+	identifiedServerStream struct {
+		grpc.ServerStream
+		ctx context.Context
+	}
+)
+
+const AUTHORIZATION_METADATA = "authorization"
+
+var publicGRPCMethods = map[string]bool{
+	"/manager.GrpcManager/RegisterPeer": true,
+}
+
+func NewAuthUnaryInterceptor(authManager *AuthManager) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if publicGRPCMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		token := tokenFromMetadata(ctx)
+		if r, ok := req.(tokenRequest); ok && token == "" {
+			token = r.GetToken()
+		}
+		identity, err := authenticateGRPC(authManager, token)
+		if err != nil {
+			return nil, err
+		}
+		return handler(context.WithValue(ctx, identityContextKey{}, identity), req)
+	}
+}
+
+func NewAuthStreamInterceptor(authManager *AuthManager) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		token := tokenFromMetadata(stream.Context())
+		if publicGRPCMethods[info.FullMethod] || token == "" {
+			return handler(srv, stream)
+		}
+		identity, err := authenticateGRPC(authManager, token)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &identifiedServerStream{
+			ServerStream: stream,
+			ctx:          context.WithValue(stream.Context(), identityContextKey{}, identity),
+		})
+	}
+}
+
+func IdentityFromContext(ctx context.Context) (identity *Identity, ok bool) {
+	identity, ok = ctx.Value(identityContextKey{}).(*Identity)
+	return
+}
+
+func authenticateGRPC(authManager *AuthManager, token string) (identity *Identity, err error) {
+	if token == "" {
+		err = status.Error(codes.Unauthenticated, "no token provided")
+		return
+	}
+	if identity, err = authManager.Authenticate(token); err != nil {
+		err = status.Error(codes.Unauthenticated, err.Error())
+	}
+	return
+}
+
+func tokenFromMetadata(ctx context.Context) (token string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return
+	}
+	for _, value := range md.Get(AUTHORIZATION_METADATA) {
+		if token = strings.TrimSpace(strings.TrimPrefix(value, "Bearer ")); token != "" {
+			return
+		}
+	}
+	return
+}
+
+func (stream *identifiedServerStream) Context() context.Context {
+	return stream.ctx
+}
@@ -0,0 +1,91 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthUnaryInterceptor(t *testing.T) {
+	m := NewMemoryManager()
+	token := newTestSession(t, m, "lolo")
+	interceptor := NewAuthUnaryInterceptor(m.AuthManager)
+	info := &grpc.UnaryServerInfo{FullMethod: "/manager.GrpcManager/CreateSquad"}
+	var identity *Identity
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		identity, _ = IdentityFromContext(ctx)
+		return nil, nil
+	}
+	if _, err := interceptor(context.Background(), &SquadCreateRequest{UserId: "lolo"}, info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected an unauthenticated error without a token, got %v", err)
+	}
+	if _, err := interceptor(context.Background(), &SquadCreateRequest{Token: "forged", UserId: "lolo"}, info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected an unauthenticated error with an invalid token, got %v", err)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AUTHORIZATION_METADATA, "Bearer "+token))
+	if _, err := interceptor(ctx, &SquadCreateRequest{UserId: "lolo2"}, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	if identity == nil || identity.PeerId != "lolo" {
+		t.Errorf("expected the identity of lolo in the context, got %v", identity)
+	}
+	identity = nil
+	if _, err := interceptor(context.Background(), &SquadCreateRequest{Token: token}, info, handler); err != nil || identity == nil {
+		t.Errorf("expected the request token to be accepted, got %v", err)
+	}
+	if _, err := interceptor(context.Background(), &PeerRegisterRequest{}, &grpc.UnaryServerInfo{FullMethod: "/manager.GrpcManager/RegisterPeer"}, handler); err != nil {
+		t.Errorf("expected RegisterPeer to be public, got %v", err)
+	}
+}
+
+func TestCreateSquadUsesIdentity(t *testing.T) {
+	m := NewMemoryManager()
+	service := NewGRPCManagerService(m)
+	if _, err := service.CreateSquad(context.Background(), &SquadCreateRequest{UserId: "lolo", Name: "test squad"}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected an unauthenticated error without identity, got %v", err)
+	}
+	identity := &Identity{PeerId: "lolo", Token: newTestSession(t, m, "lolo")}
+	res, err := service.CreateSquad(context.WithValue(context.Background(), identityContextKey{}, identity), &SquadCreateRequest{UserId: "lolo2", Name: "test squad"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Success || res.Squad.Owner != "lolo" {
+		t.Errorf("expected lolo to own the squad, got %v", res.Squad)
+	}
+}
+
+func TestLeaveSquadRPC(t *testing.T) {
+	m := NewMemoryManager()
+	service := NewGRPCManagerService(m)
+	identity := &Identity{PeerId: "lolo", Token: newTestSession(t, m, "lolo")}
+	ctx := context.WithValue(context.Background(), identityContextKey{}, identity)
+	created, err := service.CreateSquad(ctx, &SquadCreateRequest{Name: "test squad", SquadType: string(PUBLIC)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	squadId := created.Squad.Id
+	if err = m.ConnectToSquad(identity.Token, squadId, "lolo", "", "", MESH); err != nil {
+		t.Fatal(err)
+	}
+	res, err := service.LeaveSquad(ctx, &SquadLeaveRequest{SquadId: squadId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Success || res.SquadId != squadId {
+		t.Errorf("expected lolo to leave the squad, got %v", res)
+	}
+	squad, err := m.SquadStore.GetSquad(context.Background(), squadId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if containsPeer(squad.Members, "lolo") {
+		t.Errorf("expected lolo to be gone from %v", squad.Members)
+	}
+	if _, err = service.LeaveSquad(ctx, &SquadLeaveRequest{SquadId: squadId}); err == nil {
+		t.Error("expected leaving a squad twice to fail")
+	}
+}
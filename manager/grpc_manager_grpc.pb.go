@@ -27,6 +27,30 @@ type GrpcManagerClient interface {
 	ListSquad(ctx context.Context, in *SquadListRequest, opts ...grpc.CallOption) (*SquadListResponse, error)
 	ConnectSquad(ctx context.Context, in *SquadConnectRequest, opts ...grpc.CallOption) (*SquadConnectResponse, error)
 	LeaveSquad(ctx context.Context, in *SquadLeaveRequest, opts ...grpc.CallOption) (*SquadLeaveResponse, error)
+	AddPeerKey(ctx context.Context, in *PeerKeyAddRequest, opts ...grpc.CallOption) (*PeerKeyAddResponse, error)
+	ListPeerKeys(ctx context.Context, in *PeerKeyListRequest, opts ...grpc.CallOption) (*PeerKeyListResponse, error)
+	RevokePeerKey(ctx context.Context, in *PeerKeyRevokeRequest, opts ...grpc.CallOption) (*PeerKeyRevokeResponse, error)
+	KickSquadMember(ctx context.Context, in *SquadMemberRequest, opts ...grpc.CallOption) (*SquadMemberResponse, error)
+	BanSquadMember(ctx context.Context, in *SquadMemberRequest, opts ...grpc.CallOption) (*SquadMemberResponse, error)
+	UnbanSquadMember(ctx context.Context, in *SquadMemberRequest, opts ...grpc.CallOption) (*SquadMemberResponse, error)
+	SetSquadRole(ctx context.Context, in *SquadMemberRequest, opts ...grpc.CallOption) (*SquadMemberResponse, error)
+	TransferSquadOwnership(ctx context.Context, in *SquadMemberRequest, opts ...grpc.CallOption) (*SquadMemberResponse, error)
+	SetPresence(ctx context.Context, in *PresenceSetRequest, opts ...grpc.CallOption) (*PresenceSetResponse, error)
+	SubscribePresence(ctx context.Context, in *PresenceSubscribeRequest, opts ...grpc.CallOption) (*PresenceSubscribeResponse, error)
+	UnsubscribePresence(ctx context.Context, in *PresenceSubscribeRequest, opts ...grpc.CallOption) (*PresenceSubscribeResponse, error)
+	SendFriendRequest(ctx context.Context, in *FriendPeerRequest, opts ...grpc.CallOption) (*FriendResponse, error)
+	AcceptFriendRequest(ctx context.Context, in *FriendRequestAnswer, opts ...grpc.CallOption) (*FriendResponse, error)
+	DeclineFriendRequest(ctx context.Context, in *FriendRequestAnswer, opts ...grpc.CallOption) (*FriendResponse, error)
+	CancelFriendRequest(ctx context.Context, in *FriendRequestAnswer, opts ...grpc.CallOption) (*FriendResponse, error)
+	RemoveFriend(ctx context.Context, in *FriendPeerRequest, opts ...grpc.CallOption) (*FriendResponse, error)
+	BlockPeer(ctx context.Context, in *FriendPeerRequest, opts ...grpc.CallOption) (*FriendResponse, error)
+	UnblockPeer(ctx context.Context, in *FriendPeerRequest, opts ...grpc.CallOption) (*FriendResponse, error)
+	ListFriends(ctx context.Context, in *FriendListRequest, opts ...grpc.CallOption) (*FriendListResponse, error)
+	SetSquadMeshLimit(ctx context.Context, in *SquadMeshLimitRequest, opts ...grpc.CallOption) (*SquadUpdateResponse, error)
+	RegisterHost(ctx context.Context, in *HostRegisterRequest, opts ...grpc.CallOption) (*HostRegisterResponse, error)
+	UnregisterHost(ctx context.Context, in *HostUnregisterRequest, opts ...grpc.CallOption) (*HostUnregisterResponse, error)
+	ListHosts(ctx context.Context, in *HostListRequest, opts ...grpc.CallOption) (*HostListResponse, error)
+	GetICEServers(ctx context.Context, in *ICEServersRequest, opts ...grpc.CallOption) (*ICEServersResponse, error)
 }
 
 type grpcManagerClient struct {
@@ -140,6 +164,222 @@ func (c *grpcManagerClient) LeaveSquad(ctx context.Context, in *SquadLeaveReques
 	return out, nil
 }
 
+func (c *grpcManagerClient) AddPeerKey(ctx context.Context, in *PeerKeyAddRequest, opts ...grpc.CallOption) (*PeerKeyAddResponse, error) {
+	out := new(PeerKeyAddResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/AddPeerKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) ListPeerKeys(ctx context.Context, in *PeerKeyListRequest, opts ...grpc.CallOption) (*PeerKeyListResponse, error) {
+	out := new(PeerKeyListResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/ListPeerKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) RevokePeerKey(ctx context.Context, in *PeerKeyRevokeRequest, opts ...grpc.CallOption) (*PeerKeyRevokeResponse, error) {
+	out := new(PeerKeyRevokeResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/RevokePeerKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) KickSquadMember(ctx context.Context, in *SquadMemberRequest, opts ...grpc.CallOption) (*SquadMemberResponse, error) {
+	out := new(SquadMemberResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/KickSquadMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) BanSquadMember(ctx context.Context, in *SquadMemberRequest, opts ...grpc.CallOption) (*SquadMemberResponse, error) {
+	out := new(SquadMemberResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/BanSquadMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) UnbanSquadMember(ctx context.Context, in *SquadMemberRequest, opts ...grpc.CallOption) (*SquadMemberResponse, error) {
+	out := new(SquadMemberResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/UnbanSquadMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) SetSquadRole(ctx context.Context, in *SquadMemberRequest, opts ...grpc.CallOption) (*SquadMemberResponse, error) {
+	out := new(SquadMemberResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/SetSquadRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) TransferSquadOwnership(ctx context.Context, in *SquadMemberRequest, opts ...grpc.CallOption) (*SquadMemberResponse, error) {
+	out := new(SquadMemberResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/TransferSquadOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) SetPresence(ctx context.Context, in *PresenceSetRequest, opts ...grpc.CallOption) (*PresenceSetResponse, error) {
+	out := new(PresenceSetResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/SetPresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) SubscribePresence(ctx context.Context, in *PresenceSubscribeRequest, opts ...grpc.CallOption) (*PresenceSubscribeResponse, error) {
+	out := new(PresenceSubscribeResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/SubscribePresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) UnsubscribePresence(ctx context.Context, in *PresenceSubscribeRequest, opts ...grpc.CallOption) (*PresenceSubscribeResponse, error) {
+	out := new(PresenceSubscribeResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/UnsubscribePresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) SendFriendRequest(ctx context.Context, in *FriendPeerRequest, opts ...grpc.CallOption) (*FriendResponse, error) {
+	out := new(FriendResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/SendFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) AcceptFriendRequest(ctx context.Context, in *FriendRequestAnswer, opts ...grpc.CallOption) (*FriendResponse, error) {
+	out := new(FriendResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/AcceptFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) DeclineFriendRequest(ctx context.Context, in *FriendRequestAnswer, opts ...grpc.CallOption) (*FriendResponse, error) {
+	out := new(FriendResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/DeclineFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) CancelFriendRequest(ctx context.Context, in *FriendRequestAnswer, opts ...grpc.CallOption) (*FriendResponse, error) {
+	out := new(FriendResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/CancelFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) RemoveFriend(ctx context.Context, in *FriendPeerRequest, opts ...grpc.CallOption) (*FriendResponse, error) {
+	out := new(FriendResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/RemoveFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) BlockPeer(ctx context.Context, in *FriendPeerRequest, opts ...grpc.CallOption) (*FriendResponse, error) {
+	out := new(FriendResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/BlockPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) UnblockPeer(ctx context.Context, in *FriendPeerRequest, opts ...grpc.CallOption) (*FriendResponse, error) {
+	out := new(FriendResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/UnblockPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) ListFriends(ctx context.Context, in *FriendListRequest, opts ...grpc.CallOption) (*FriendListResponse, error) {
+	out := new(FriendListResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/ListFriends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) SetSquadMeshLimit(ctx context.Context, in *SquadMeshLimitRequest, opts ...grpc.CallOption) (*SquadUpdateResponse, error) {
+	out := new(SquadUpdateResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/SetSquadMeshLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) RegisterHost(ctx context.Context, in *HostRegisterRequest, opts ...grpc.CallOption) (*HostRegisterResponse, error) {
+	out := new(HostRegisterResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/RegisterHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) UnregisterHost(ctx context.Context, in *HostUnregisterRequest, opts ...grpc.CallOption) (*HostUnregisterResponse, error) {
+	out := new(HostUnregisterResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/UnregisterHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) ListHosts(ctx context.Context, in *HostListRequest, opts ...grpc.CallOption) (*HostListResponse, error) {
+	out := new(HostListResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/ListHosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcManagerClient) GetICEServers(ctx context.Context, in *ICEServersRequest, opts ...grpc.CallOption) (*ICEServersResponse, error) {
+	out := new(ICEServersResponse)
+	err := c.cc.Invoke(ctx, "/manager.GrpcManager/GetICEServers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GrpcManagerServer is the server API for GrpcManager service.
 // All implementations must embed UnimplementedGrpcManagerServer
 // for forward compatibility
@@ -153,6 +393,30 @@ type GrpcManagerServer interface {
 	ListSquad(context.Context, *SquadListRequest) (*SquadListResponse, error)
 	ConnectSquad(context.Context, *SquadConnectRequest) (*SquadConnectResponse, error)
 	LeaveSquad(context.Context, *SquadLeaveRequest) (*SquadLeaveResponse, error)
+	AddPeerKey(context.Context, *PeerKeyAddRequest) (*PeerKeyAddResponse, error)
+	ListPeerKeys(context.Context, *PeerKeyListRequest) (*PeerKeyListResponse, error)
+	RevokePeerKey(context.Context, *PeerKeyRevokeRequest) (*PeerKeyRevokeResponse, error)
+	KickSquadMember(context.Context, *SquadMemberRequest) (*SquadMemberResponse, error)
+	BanSquadMember(context.Context, *SquadMemberRequest) (*SquadMemberResponse, error)
+	UnbanSquadMember(context.Context, *SquadMemberRequest) (*SquadMemberResponse, error)
+	SetSquadRole(context.Context, *SquadMemberRequest) (*SquadMemberResponse, error)
+	TransferSquadOwnership(context.Context, *SquadMemberRequest) (*SquadMemberResponse, error)
+	SetPresence(context.Context, *PresenceSetRequest) (*PresenceSetResponse, error)
+	SubscribePresence(context.Context, *PresenceSubscribeRequest) (*PresenceSubscribeResponse, error)
+	UnsubscribePresence(context.Context, *PresenceSubscribeRequest) (*PresenceSubscribeResponse, error)
+	SendFriendRequest(context.Context, *FriendPeerRequest) (*FriendResponse, error)
+	AcceptFriendRequest(context.Context, *FriendRequestAnswer) (*FriendResponse, error)
+	DeclineFriendRequest(context.Context, *FriendRequestAnswer) (*FriendResponse, error)
+	CancelFriendRequest(context.Context, *FriendRequestAnswer) (*FriendResponse, error)
+	RemoveFriend(context.Context, *FriendPeerRequest) (*FriendResponse, error)
+	BlockPeer(context.Context, *FriendPeerRequest) (*FriendResponse, error)
+	UnblockPeer(context.Context, *FriendPeerRequest) (*FriendResponse, error)
+	ListFriends(context.Context, *FriendListRequest) (*FriendListResponse, error)
+	SetSquadMeshLimit(context.Context, *SquadMeshLimitRequest) (*SquadUpdateResponse, error)
+	RegisterHost(context.Context, *HostRegisterRequest) (*HostRegisterResponse, error)
+	UnregisterHost(context.Context, *HostUnregisterRequest) (*HostUnregisterResponse, error)
+	ListHosts(context.Context, *HostListRequest) (*HostListResponse, error)
+	GetICEServers(context.Context, *ICEServersRequest) (*ICEServersResponse, error)
 	mustEmbedUnimplementedGrpcManagerServer()
 }
 
@@ -187,6 +451,78 @@ func (UnimplementedGrpcManagerServer) ConnectSquad(context.Context, *SquadConnec
 func (UnimplementedGrpcManagerServer) LeaveSquad(context.Context, *SquadLeaveRequest) (*SquadLeaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveSquad not implemented")
 }
+func (UnimplementedGrpcManagerServer) AddPeerKey(context.Context, *PeerKeyAddRequest) (*PeerKeyAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeerKey not implemented")
+}
+func (UnimplementedGrpcManagerServer) ListPeerKeys(context.Context, *PeerKeyListRequest) (*PeerKeyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeerKeys not implemented")
+}
+func (UnimplementedGrpcManagerServer) RevokePeerKey(context.Context, *PeerKeyRevokeRequest) (*PeerKeyRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePeerKey not implemented")
+}
+func (UnimplementedGrpcManagerServer) KickSquadMember(context.Context, *SquadMemberRequest) (*SquadMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickSquadMember not implemented")
+}
+func (UnimplementedGrpcManagerServer) BanSquadMember(context.Context, *SquadMemberRequest) (*SquadMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanSquadMember not implemented")
+}
+func (UnimplementedGrpcManagerServer) UnbanSquadMember(context.Context, *SquadMemberRequest) (*SquadMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanSquadMember not implemented")
+}
+func (UnimplementedGrpcManagerServer) SetSquadRole(context.Context, *SquadMemberRequest) (*SquadMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSquadRole not implemented")
+}
+func (UnimplementedGrpcManagerServer) TransferSquadOwnership(context.Context, *SquadMemberRequest) (*SquadMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferSquadOwnership not implemented")
+}
+func (UnimplementedGrpcManagerServer) SetPresence(context.Context, *PresenceSetRequest) (*PresenceSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPresence not implemented")
+}
+func (UnimplementedGrpcManagerServer) SubscribePresence(context.Context, *PresenceSubscribeRequest) (*PresenceSubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribePresence not implemented")
+}
+func (UnimplementedGrpcManagerServer) UnsubscribePresence(context.Context, *PresenceSubscribeRequest) (*PresenceSubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribePresence not implemented")
+}
+func (UnimplementedGrpcManagerServer) SendFriendRequest(context.Context, *FriendPeerRequest) (*FriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFriendRequest not implemented")
+}
+func (UnimplementedGrpcManagerServer) AcceptFriendRequest(context.Context, *FriendRequestAnswer) (*FriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendRequest not implemented")
+}
+func (UnimplementedGrpcManagerServer) DeclineFriendRequest(context.Context, *FriendRequestAnswer) (*FriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineFriendRequest not implemented")
+}
+func (UnimplementedGrpcManagerServer) CancelFriendRequest(context.Context, *FriendRequestAnswer) (*FriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFriendRequest not implemented")
+}
+func (UnimplementedGrpcManagerServer) RemoveFriend(context.Context, *FriendPeerRequest) (*FriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriend not implemented")
+}
+func (UnimplementedGrpcManagerServer) BlockPeer(context.Context, *FriendPeerRequest) (*FriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockPeer not implemented")
+}
+func (UnimplementedGrpcManagerServer) UnblockPeer(context.Context, *FriendPeerRequest) (*FriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockPeer not implemented")
+}
+func (UnimplementedGrpcManagerServer) ListFriends(context.Context, *FriendListRequest) (*FriendListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
+}
+func (UnimplementedGrpcManagerServer) SetSquadMeshLimit(context.Context, *SquadMeshLimitRequest) (*SquadUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSquadMeshLimit not implemented")
+}
+func (UnimplementedGrpcManagerServer) RegisterHost(context.Context, *HostRegisterRequest) (*HostRegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterHost not implemented")
+}
+func (UnimplementedGrpcManagerServer) UnregisterHost(context.Context, *HostUnregisterRequest) (*HostUnregisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterHost not implemented")
+}
+func (UnimplementedGrpcManagerServer) ListHosts(context.Context, *HostListRequest) (*HostListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHosts not implemented")
+}
+func (UnimplementedGrpcManagerServer) GetICEServers(context.Context, *ICEServersRequest) (*ICEServersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetICEServers not implemented")
+}
 func (UnimplementedGrpcManagerServer) mustEmbedUnimplementedGrpcManagerServer() {}
 
 // UnsafeGrpcManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -370,44 +706,572 @@ func _GrpcManager_LeaveSquad_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-// GrpcManager_ServiceDesc is the grpc.ServiceDesc for GrpcManager service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var GrpcManager_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "manager.GrpcManager",
-	HandlerType: (*GrpcManagerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterPeer",
-			Handler:    _GrpcManager_RegisterPeer_Handler,
-		},
-		{
-			MethodName: "ListPeers",
-			Handler:    _GrpcManager_ListPeers_Handler,
-		},
-		{
-			MethodName: "CreateSquad",
-			Handler:    _GrpcManager_CreateSquad_Handler,
-		},
-		{
-			MethodName: "UpdateSquad",
-			Handler:    _GrpcManager_UpdateSquad_Handler,
-		},
-		{
-			MethodName: "DeleteSquad",
-			Handler:    _GrpcManager_DeleteSquad_Handler,
-		},
-		{
-			MethodName: "ListSquad",
-			Handler:    _GrpcManager_ListSquad_Handler,
-		},
-		{
-			MethodName: "ConnectSquad",
-			Handler:    _GrpcManager_ConnectSquad_Handler,
-		},
-		{
-			MethodName: "LeaveSquad",
-			Handler:    _GrpcManager_LeaveSquad_Handler,
+func _GrpcManager_AddPeerKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerKeyAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).AddPeerKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/AddPeerKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).AddPeerKey(ctx, req.(*PeerKeyAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_ListPeerKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerKeyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).ListPeerKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/ListPeerKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).ListPeerKeys(ctx, req.(*PeerKeyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_RevokePeerKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerKeyRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).RevokePeerKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/RevokePeerKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).RevokePeerKey(ctx, req.(*PeerKeyRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_KickSquadMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquadMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).KickSquadMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/KickSquadMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).KickSquadMember(ctx, req.(*SquadMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_BanSquadMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquadMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).BanSquadMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/BanSquadMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).BanSquadMember(ctx, req.(*SquadMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_UnbanSquadMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquadMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).UnbanSquadMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/UnbanSquadMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).UnbanSquadMember(ctx, req.(*SquadMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_SetSquadRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquadMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).SetSquadRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/SetSquadRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).SetSquadRole(ctx, req.(*SquadMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_TransferSquadOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquadMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).TransferSquadOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/TransferSquadOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).TransferSquadOwnership(ctx, req.(*SquadMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_SetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PresenceSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).SetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/SetPresence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).SetPresence(ctx, req.(*PresenceSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_SubscribePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PresenceSubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).SubscribePresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/SubscribePresence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).SubscribePresence(ctx, req.(*PresenceSubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_UnsubscribePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PresenceSubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).UnsubscribePresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/UnsubscribePresence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).UnsubscribePresence(ctx, req.(*PresenceSubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).SendFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/SendFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).SendFriendRequest(ctx, req.(*FriendPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_AcceptFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendRequestAnswer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).AcceptFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/AcceptFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).AcceptFriendRequest(ctx, req.(*FriendRequestAnswer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_DeclineFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendRequestAnswer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).DeclineFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/DeclineFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).DeclineFriendRequest(ctx, req.(*FriendRequestAnswer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_CancelFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendRequestAnswer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).CancelFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/CancelFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).CancelFriendRequest(ctx, req.(*FriendRequestAnswer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).RemoveFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/RemoveFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).RemoveFriend(ctx, req.(*FriendPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_BlockPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).BlockPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/BlockPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).BlockPeer(ctx, req.(*FriendPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_UnblockPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).UnblockPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/UnblockPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).UnblockPeer(ctx, req.(*FriendPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).ListFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/ListFriends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).ListFriends(ctx, req.(*FriendListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_SetSquadMeshLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquadMeshLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).SetSquadMeshLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/SetSquadMeshLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).SetSquadMeshLimit(ctx, req.(*SquadMeshLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_RegisterHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostRegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).RegisterHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/RegisterHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).RegisterHost(ctx, req.(*HostRegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_UnregisterHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostUnregisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).UnregisterHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/UnregisterHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).UnregisterHost(ctx, req.(*HostUnregisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_ListHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).ListHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/ListHosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).ListHosts(ctx, req.(*HostListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcManager_GetICEServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ICEServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcManagerServer).GetICEServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.GrpcManager/GetICEServers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcManagerServer).GetICEServers(ctx, req.(*ICEServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GrpcManager_ServiceDesc is the grpc.ServiceDesc for GrpcManager service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GrpcManager_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "manager.GrpcManager",
+	HandlerType: (*GrpcManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterPeer",
+			Handler:    _GrpcManager_RegisterPeer_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _GrpcManager_ListPeers_Handler,
+		},
+		{
+			MethodName: "CreateSquad",
+			Handler:    _GrpcManager_CreateSquad_Handler,
+		},
+		{
+			MethodName: "UpdateSquad",
+			Handler:    _GrpcManager_UpdateSquad_Handler,
+		},
+		{
+			MethodName: "DeleteSquad",
+			Handler:    _GrpcManager_DeleteSquad_Handler,
+		},
+		{
+			MethodName: "ListSquad",
+			Handler:    _GrpcManager_ListSquad_Handler,
+		},
+		{
+			MethodName: "ConnectSquad",
+			Handler:    _GrpcManager_ConnectSquad_Handler,
+		},
+		{
+			MethodName: "LeaveSquad",
+			Handler:    _GrpcManager_LeaveSquad_Handler,
+		},
+		{
+			MethodName: "AddPeerKey",
+			Handler:    _GrpcManager_AddPeerKey_Handler,
+		},
+		{
+			MethodName: "ListPeerKeys",
+			Handler:    _GrpcManager_ListPeerKeys_Handler,
+		},
+		{
+			MethodName: "RevokePeerKey",
+			Handler:    _GrpcManager_RevokePeerKey_Handler,
+		},
+		{
+			MethodName: "KickSquadMember",
+			Handler:    _GrpcManager_KickSquadMember_Handler,
+		},
+		{
+			MethodName: "BanSquadMember",
+			Handler:    _GrpcManager_BanSquadMember_Handler,
+		},
+		{
+			MethodName: "UnbanSquadMember",
+			Handler:    _GrpcManager_UnbanSquadMember_Handler,
+		},
+		{
+			MethodName: "SetSquadRole",
+			Handler:    _GrpcManager_SetSquadRole_Handler,
+		},
+		{
+			MethodName: "TransferSquadOwnership",
+			Handler:    _GrpcManager_TransferSquadOwnership_Handler,
+		},
+		{
+			MethodName: "SetPresence",
+			Handler:    _GrpcManager_SetPresence_Handler,
+		},
+		{
+			MethodName: "SubscribePresence",
+			Handler:    _GrpcManager_SubscribePresence_Handler,
+		},
+		{
+			MethodName: "UnsubscribePresence",
+			Handler:    _GrpcManager_UnsubscribePresence_Handler,
+		},
+		{
+			MethodName: "SendFriendRequest",
+			Handler:    _GrpcManager_SendFriendRequest_Handler,
+		},
+		{
+			MethodName: "AcceptFriendRequest",
+			Handler:    _GrpcManager_AcceptFriendRequest_Handler,
+		},
+		{
+			MethodName: "DeclineFriendRequest",
+			Handler:    _GrpcManager_DeclineFriendRequest_Handler,
+		},
+		{
+			MethodName: "CancelFriendRequest",
+			Handler:    _GrpcManager_CancelFriendRequest_Handler,
+		},
+		{
+			MethodName: "RemoveFriend",
+			Handler:    _GrpcManager_RemoveFriend_Handler,
+		},
+		{
+			MethodName: "BlockPeer",
+			Handler:    _GrpcManager_BlockPeer_Handler,
+		},
+		{
+			MethodName: "UnblockPeer",
+			Handler:    _GrpcManager_UnblockPeer_Handler,
+		},
+		{
+			MethodName: "ListFriends",
+			Handler:    _GrpcManager_ListFriends_Handler,
+		},
+		{
+			MethodName: "SetSquadMeshLimit",
+			Handler:    _GrpcManager_SetSquadMeshLimit_Handler,
+		},
+		{
+			MethodName: "RegisterHost",
+			Handler:    _GrpcManager_RegisterHost_Handler,
+		},
+		{
+			MethodName: "UnregisterHost",
+			Handler:    _GrpcManager_UnregisterHost_Handler,
+		},
+		{
+			MethodName: "ListHosts",
+			Handler:    _GrpcManager_ListHosts_Handler,
+		},
+		{
+			MethodName: "GetICEServers",
+			Handler:    _GrpcManager_GetICEServers_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
@@ -2,10 +2,13 @@ package manager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -33,13 +36,21 @@ func (service *GRPCManagerService) Link(stream GrpcManager_LinkServer) (err erro
 		if err != nil {
 			errch <- err
 			return
-		} else if service.Manager.State == ON {
-			if err := service.Manager.AddGrpcPeer(stream, req.From, req); err != nil {
-				errch <- err
-				return
-			}
-			done <- struct{}{}
 		}
+		identity, err := service.linkIdentity(stream.Context(), req)
+		if err != nil {
+			errch <- err
+			return
+		}
+		if !service.Manager.Accepting() {
+			errch <- status.Error(codes.Unavailable, ErrDraining.Error())
+			return
+		}
+		if err := service.Manager.AddGrpcPeer(stream, identity.PeerId, req); err != nil {
+			errch <- err
+			return
+		}
+		done <- struct{}{}
 	}()
 	select {
 	case <-stream.Context().Done():
@@ -55,23 +66,35 @@ func (service *GRPCManagerService) Link(stream GrpcManager_LinkServer) (err erro
 	}
 }
 
+func (service *GRPCManagerService) identity(ctx context.Context) (identity *Identity, err error) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		err = status.Error(codes.Unauthenticated, "no authenticated peer")
+	}
+	return
+}
+
+func (service *GRPCManagerService) linkIdentity(ctx context.Context, req *Request) (identity *Identity, err error) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		if identity, err = authenticateGRPC(service.Manager.AuthManager, req.Token); err != nil {
+			return
+		}
+	}
+	if req.From != "" && req.From != identity.PeerId {
+		identity, err = nil, status.Errorf(codes.PermissionDenied, "authenticated as %s but linking as %s", identity.PeerId, req.From)
+		return
+	}
+	req.From = identity.PeerId
+	return
+}
+
 func (service *GRPCManagerService) ListPeers(ctx context.Context, peerListRequest *PeerListRequest) (peerListResponse *PeerListResponse, err error) {
 	list, errch := make(chan []*Peer), make(chan error)
 	go func() {
 		peerList := []*Peer{}
 		count := 0
-		for id := range service.Manager.GRPCPeers {
-			if count < int(peerListRequest.Number) {
-				peer := &Peer{
-					Id:   id,
-					Name: "unknown",
-				}
-				peerList = append(peerList, peer)
-			} else {
-				break
-			}
-		}
-		for id := range service.Manager.WSPeers {
+		for _, id := range service.Manager.Router.PeerIds() {
 			if count < int(peerListRequest.Number) {
 				peer := &Peer{
 					Id:   id,
@@ -103,6 +126,10 @@ func (service *GRPCManagerService) ListPeers(ctx context.Context, peerListReques
 }
 
 func (service *GRPCManagerService) CreateSquad(ctx context.Context, req *SquadCreateRequest) (res *SquadCreateResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
 	done, errch := make(chan *SquadCreateResponse), make(chan error)
 	go func() {
 		uid, uidErr := uuid.NewUUID()
@@ -110,8 +137,16 @@ func (service *GRPCManagerService) CreateSquad(ctx context.Context, req *SquadCr
 			errch <- uidErr
 			return
 		}
-		if err := service.Manager.CreateSquad(req.Token, uid.String(), req.UserId, req.Name, SquadType(req.SquadType), req.Password, "mesh", "lolo_local_serv"); err != nil {
-			errch <- err
+		networkType, host := req.NetworkType, req.Host
+		if networkType == "" {
+			networkType = MESH
+		}
+		host, createErr := service.Manager.createSquad(identity.Token, uid.String(), identity.PeerId, req.Name, SquadType(req.SquadType), req.Password, networkType, host, req.Region)
+		if errors.Is(createErr, ErrNoHost) {
+			errch <- status.Error(codes.Unavailable, createErr.Error())
+			return
+		} else if createErr != nil {
+			errch <- createErr
 			return
 		}
 		done <- &SquadCreateResponse{
@@ -121,7 +156,8 @@ func (service *GRPCManagerService) CreateSquad(ctx context.Context, req *SquadCr
 				Name:    req.Name,
 				Id:      uid.String(),
 				Members: make([]string, 0),
-				Owner:   req.UserId,
+				Owner:   identity.PeerId,
+				Host:    host,
 			},
 		}
 	}()
@@ -137,22 +173,25 @@ func (service *GRPCManagerService) CreateSquad(ctx context.Context, req *SquadCr
 }
 
 func (service *GRPCManagerService) UpdateSquad(ctx context.Context, req *SquadUpdateRequest) (res *SquadUpdateResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
 	done, errch := make(chan *SquadUpdateResponse), make(chan error)
 	go func() {
-		if err := service.Manager.ModifySquad(req.Token, req.Id, req.UserId, req.Name, SquadType(req.SquadType), req.Password); err != nil {
+		if err := service.Manager.ModifySquad(identity.Token, req.Id, identity.PeerId, req.Name, SquadType(req.SquadType), req.Password); err != nil {
+			errch <- err
+			return
+		}
+		squad, _, err := service.Manager.findSquad(req.Id)
+		if err != nil {
 			errch <- err
 			return
 		}
 		done <- &SquadUpdateResponse{
 			Success: true,
 			Reason:  fmt.Sprintf("Squad %s updated", req.Id),
-			Squad: &ProtoSquad{
-				Id:        req.Id,
-				Name:      req.Name,
-				Members:   service.Manager.Squads[req.Id].Members,
-				Owner:     req.UserId,
-				SquadType: req.SquadType,
-			},
+			Squad:   protoSquad(squad),
 		}
 	}()
 	select {
@@ -167,9 +206,13 @@ func (service *GRPCManagerService) UpdateSquad(ctx context.Context, req *SquadUp
 }
 
 func (service *GRPCManagerService) DeleteSquad(ctx context.Context, req *SquadDeleteRequest) (res *SquadDeleteResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
 	done, errch := make(chan *SquadDeleteResponse), make(chan error)
 	go func() {
-		if err := service.Manager.DeleteSquad(req.Token, req.SquadId, req.UserId); err != nil {
+		if err := service.Manager.DeleteSquad(identity.Token, req.SquadId, identity.PeerId); err != nil {
 			errch <- err
 			return
 		}
@@ -197,13 +240,7 @@ func (service *GRPCManagerService) ListSquad(ctx context.Context, req *SquadList
 		count := 0
 		for _, squad := range service.Manager.Squads {
 			if count < int(req.Number) {
-				squadList = append(squadList, &ProtoSquad{
-					Id:        squad.ID,
-					Name:      squad.Name,
-					Owner:     squad.Owner,
-					Members:   squad.Members,
-					SquadType: string(squad.SquadType),
-				})
+				squadList = append(squadList, protoSquad(squad))
 			} else {
 				break
 			}
@@ -226,9 +263,13 @@ func (service *GRPCManagerService) ListSquad(ctx context.Context, req *SquadList
 }
 
 func (service *GRPCManagerService) ConnectSquad(ctx context.Context, req *SquadConnectRequest) (res *SquadConnectResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
 	done, errch := make(chan *SquadConnectResponse), make(chan error)
 	go func() {
-		if err := service.Manager.ConnectToSquad(req.Token, req.Id, req.UserId, req.Password, ""); err != nil {
+		if err := service.Manager.ConnectToSquad(identity.Token, req.Id, identity.PeerId, req.Password, req.InviteCode, req.NetworkType); err != nil {
 			errch <- err
 			return
 		}
@@ -250,9 +291,13 @@ func (service *GRPCManagerService) ConnectSquad(ctx context.Context, req *SquadC
 }
 
 func (service *GRPCManagerService) LeaveSquad(ctx context.Context, req *SquadLeaveRequest) (res *SquadLeaveResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
 	done, errch := make(chan *SquadLeaveResponse), make(chan error)
 	go func() {
-		if err := service.Manager.LeaveSquad(req.SquadId, req.UserId, ""); err != nil {
+		if err := service.Manager.LeaveSquad(identity.Token, req.SquadId, identity.PeerId, ""); err != nil {
 			errch <- err
 			return
 		}
@@ -272,3 +317,396 @@ func (service *GRPCManagerService) LeaveSquad(ctx context.Context, req *SquadLea
 		return
 	}
 }
+
+func (service *GRPCManagerService) AddPeerKey(ctx context.Context, req *PeerKeyAddRequest) (res *PeerKeyAddResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	done, errch := make(chan *PeerKeyAddResponse), make(chan error)
+	go func() {
+		key, err := service.Manager.AddPeerKey(identity.Token, identity.PeerId, req.Label, req.PubKey)
+		if err != nil {
+			errch <- err
+			return
+		}
+		done <- &PeerKeyAddResponse{
+			Success: true,
+			Reason:  fmt.Sprintf("added key %s", key.Id),
+			Key:     key,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+		return
+	case err = <-errch:
+		return
+	case res = <-done:
+		return
+	}
+}
+
+func (service *GRPCManagerService) ListPeerKeys(ctx context.Context, req *PeerKeyListRequest) (res *PeerKeyListResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	keys, err := service.Manager.ListPeerKeys(identity.Token, identity.PeerId)
+	if err != nil {
+		return
+	}
+	res = &PeerKeyListResponse{
+		Success: true,
+		Keys:    keys,
+	}
+	return
+}
+
+func (service *GRPCManagerService) RevokePeerKey(ctx context.Context, req *PeerKeyRevokeRequest) (res *PeerKeyRevokeResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	done, errch := make(chan *PeerKeyRevokeResponse), make(chan error)
+	go func() {
+		if err := service.Manager.RevokePeerKey(identity.Token, identity.PeerId, req.KeyId); err != nil {
+			errch <- err
+			return
+		}
+		done <- &PeerKeyRevokeResponse{
+			Success: true,
+			Reason:  fmt.Sprintf("revoked key %s", req.KeyId),
+			KeyId:   req.KeyId,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+		return
+	case err = <-errch:
+		return
+	case res = <-done:
+		return
+	}
+}
+
+func (service *GRPCManagerService) KickSquadMember(ctx context.Context, req *SquadMemberRequest) (res *SquadMemberResponse, err error) {
+	res, err = service.squadMember(ctx, req, func(identity *Identity) error {
+		return service.Manager.KickFromSquad(identity.Token, req.SquadId, identity.PeerId, req.PeerId)
+	})
+	return
+}
+
+func (service *GRPCManagerService) BanSquadMember(ctx context.Context, req *SquadMemberRequest) (res *SquadMemberResponse, err error) {
+	res, err = service.squadMember(ctx, req, func(identity *Identity) error {
+		return service.Manager.BanFromSquad(identity.Token, req.SquadId, identity.PeerId, req.PeerId)
+	})
+	return
+}
+
+func (service *GRPCManagerService) UnbanSquadMember(ctx context.Context, req *SquadMemberRequest) (res *SquadMemberResponse, err error) {
+	res, err = service.squadMember(ctx, req, func(identity *Identity) error {
+		return service.Manager.UnbanFromSquad(identity.Token, req.SquadId, identity.PeerId, req.PeerId)
+	})
+	return
+}
+
+func (service *GRPCManagerService) SetSquadRole(ctx context.Context, req *SquadMemberRequest) (res *SquadMemberResponse, err error) {
+	res, err = service.squadMember(ctx, req, func(identity *Identity) error {
+		return service.Manager.SetSquadRole(identity.Token, req.SquadId, identity.PeerId, req.PeerId, SquadRole(req.Role))
+	})
+	return
+}
+
+func (service *GRPCManagerService) TransferSquadOwnership(ctx context.Context, req *SquadMemberRequest) (res *SquadMemberResponse, err error) {
+	res, err = service.squadMember(ctx, req, func(identity *Identity) error {
+		return service.Manager.TransferSquadOwnership(identity.Token, req.SquadId, identity.PeerId, req.PeerId)
+	})
+	return
+}
+
+func (service *GRPCManagerService) squadMember(ctx context.Context, req *SquadMemberRequest, apply func(*Identity) error) (res *SquadMemberResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	done, errch := make(chan *SquadMemberResponse), make(chan error)
+	go func() {
+		if err := apply(identity); err != nil {
+			errch <- status.Error(codes.PermissionDenied, err.Error())
+			return
+		}
+		done <- &SquadMemberResponse{
+			Success: true,
+			Reason:  fmt.Sprintf("squad %s updated for %s", req.SquadId, req.PeerId),
+			SquadId: req.SquadId,
+			PeerId:  req.PeerId,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+		return
+	case err = <-errch:
+		return
+	case res = <-done:
+		return
+	}
+}
+
+func (service *GRPCManagerService) SetPresence(ctx context.Context, req *PresenceSetRequest) (res *PresenceSetResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	presence, err := service.Manager.SetPresence(identity.Token, identity.PeerId, PresenceStatus(req.Status))
+	if err != nil {
+		err = status.Error(codes.FailedPrecondition, err.Error())
+		return
+	}
+	res = &PresenceSetResponse{
+		Success:  true,
+		Reason:   fmt.Sprintf("presence set to %s", presence.Status),
+		Presence: presence,
+	}
+	return
+}
+
+func (service *GRPCManagerService) SubscribePresence(ctx context.Context, req *PresenceSubscribeRequest) (res *PresenceSubscribeResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	presences, err := service.Manager.SubscribePresence(identity.Token, identity.PeerId, req.Peers)
+	if err != nil {
+		err = status.Error(codes.PermissionDenied, err.Error())
+		return
+	}
+	res = &PresenceSubscribeResponse{
+		Success:   true,
+		Reason:    fmt.Sprintf("subscribed to %d peers", len(presences)),
+		Presences: presences,
+	}
+	return
+}
+
+func (service *GRPCManagerService) UnsubscribePresence(ctx context.Context, req *PresenceSubscribeRequest) (res *PresenceSubscribeResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	if err = service.Manager.UnsubscribePresence(identity.Token, identity.PeerId, req.Peers); err != nil {
+		return
+	}
+	res = &PresenceSubscribeResponse{
+		Success: true,
+		Reason:  fmt.Sprintf("unsubscribed from %d peers", len(req.Peers)),
+	}
+	return
+}
+
+func (service *GRPCManagerService) SendFriendRequest(ctx context.Context, req *FriendPeerRequest) (res *FriendResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	request, err := service.Manager.SendFriendRequest(identity.Token, identity.PeerId, req.PeerId)
+	if err != nil {
+		err = status.Error(codes.FailedPrecondition, err.Error())
+		return
+	}
+	res = &FriendResponse{
+		Success: true,
+		Reason:  fmt.Sprintf("friend request sent to %s", req.PeerId),
+		PeerId:  req.PeerId,
+		Request: request,
+	}
+	return
+}
+
+func (service *GRPCManagerService) AcceptFriendRequest(ctx context.Context, req *FriendRequestAnswer) (res *FriendResponse, err error) {
+	return service.answerFriendRequest(ctx, req, service.Manager.AcceptFriendRequest)
+}
+
+func (service *GRPCManagerService) DeclineFriendRequest(ctx context.Context, req *FriendRequestAnswer) (res *FriendResponse, err error) {
+	return service.answerFriendRequest(ctx, req, service.Manager.DeclineFriendRequest)
+}
+
+func (service *GRPCManagerService) CancelFriendRequest(ctx context.Context, req *FriendRequestAnswer) (res *FriendResponse, err error) {
+	return service.answerFriendRequest(ctx, req, service.Manager.CancelFriendRequest)
+}
+
+func (service *GRPCManagerService) answerFriendRequest(ctx context.Context, req *FriendRequestAnswer, answer func(string, string, string) (*FriendRequest, error)) (res *FriendResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	request, err := answer(identity.Token, identity.PeerId, req.RequestId)
+	if err != nil {
+		err = status.Error(codes.PermissionDenied, err.Error())
+		return
+	}
+	res = &FriendResponse{
+		Success: true,
+		Reason:  fmt.Sprintf("friend request %s answered", request.Id),
+		Request: request,
+	}
+	return
+}
+
+func (service *GRPCManagerService) RemoveFriend(ctx context.Context, req *FriendPeerRequest) (res *FriendResponse, err error) {
+	return service.friendPeer(ctx, req, service.Manager.RemoveFriend)
+}
+
+func (service *GRPCManagerService) BlockPeer(ctx context.Context, req *FriendPeerRequest) (res *FriendResponse, err error) {
+	return service.friendPeer(ctx, req, service.Manager.BlockPeer)
+}
+
+func (service *GRPCManagerService) UnblockPeer(ctx context.Context, req *FriendPeerRequest) (res *FriendResponse, err error) {
+	return service.friendPeer(ctx, req, service.Manager.UnblockPeer)
+}
+
+func (service *GRPCManagerService) friendPeer(ctx context.Context, req *FriendPeerRequest, apply func(string, string, string) error) (res *FriendResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	if err = apply(identity.Token, identity.PeerId, req.PeerId); err != nil {
+		err = status.Error(codes.FailedPrecondition, err.Error())
+		return
+	}
+	res = &FriendResponse{
+		Success: true,
+		Reason:  fmt.Sprintf("contacts updated for %s", req.PeerId),
+		PeerId:  req.PeerId,
+	}
+	return
+}
+
+func (service *GRPCManagerService) ListFriends(ctx context.Context, req *FriendListRequest) (res *FriendListResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	friends, blocked, requests, err := service.Manager.ListFriends(identity.Token, identity.PeerId)
+	if err != nil {
+		return
+	}
+	res = &FriendListResponse{
+		Success:  true,
+		Friends:  friends,
+		Blocked:  blocked,
+		Requests: requests,
+	}
+	return
+}
+
+func (service *GRPCManagerService) SetSquadMeshLimit(ctx context.Context, req *SquadMeshLimitRequest) (res *SquadUpdateResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	if err = service.Manager.SetSquadMeshLimit(identity.Token, req.SquadId, identity.PeerId, int(req.Limit)); err != nil {
+		err = status.Error(codes.PermissionDenied, err.Error())
+		return
+	}
+	squad, _, err := service.Manager.findSquad(req.SquadId)
+	if err != nil {
+		return
+	}
+	res = &SquadUpdateResponse{
+		Success: true,
+		Reason:  fmt.Sprintf("Squad %s is a mesh up to %d members", req.SquadId, service.Manager.meshLimit(squad)),
+		Squad:   protoSquad(squad),
+	}
+	return
+}
+
+func (service *GRPCManagerService) RegisterHost(ctx context.Context, req *HostRegisterRequest) (res *HostRegisterResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	host, err := service.Manager.RegisterHost(identity.Token, identity.PeerId, req.Region, req.Capacity, req.Load)
+	if err != nil {
+		err = status.Error(codes.FailedPrecondition, err.Error())
+		return
+	}
+	res = &HostRegisterResponse{
+		Success: true,
+		Reason:  fmt.Sprintf("host %s registered with a load of %d/%d", host.Id, host.Load, host.Capacity),
+		Host:    host,
+	}
+	return
+}
+
+func (service *GRPCManagerService) UnregisterHost(ctx context.Context, req *HostUnregisterRequest) (res *HostUnregisterResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	if err = service.Manager.UnregisterHost(identity.Token, identity.PeerId); err != nil {
+		err = status.Error(codes.FailedPrecondition, err.Error())
+		return
+	}
+	res = &HostUnregisterResponse{
+		Success: true,
+		Reason:  fmt.Sprintf("host %s unregistered", identity.PeerId),
+	}
+	return
+}
+
+func (service *GRPCManagerService) ListHosts(ctx context.Context, req *HostListRequest) (res *HostListResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	hosts, err := service.Manager.ListHosts(identity.Token, identity.PeerId, req.Region)
+	if err != nil {
+		return
+	}
+	res = &HostListResponse{
+		Success: true,
+		Hosts:   hosts,
+	}
+	return
+}
+
+func (service *GRPCManagerService) GetICEServers(ctx context.Context, req *ICEServersRequest) (res *ICEServersResponse, err error) {
+	identity, err := service.identity(ctx)
+	if err != nil {
+		return
+	}
+	servers, expiresAt, err := service.Manager.GetICEServers(identity.Token, identity.PeerId, req.Region)
+	if err != nil {
+		err = status.Error(codes.Unavailable, err.Error())
+		return
+	}
+	res = &ICEServersResponse{
+		Success:   true,
+		Servers:   servers,
+		ExpiresAt: expiresAt.Unix(),
+	}
+	return
+}
+
+func protoSquad(squad *Squad) *ProtoSquad {
+	roles := make(map[string]string, len(squad.Roles))
+	for peerId, role := range squad.Roles {
+		roles[peerId] = string(role)
+	}
+	return &ProtoSquad{
+		Id:                squad.ID,
+		Name:              squad.Name,
+		Members:           squad.Members,
+		SquadType:         string(squad.SquadType),
+		Owner:             squad.Owner,
+		Host:              squad.HostId,
+		Status:            squad.Status,
+		AuthorizedMembers: squad.AuthorizedMembers,
+		Roles:             roles,
+		NetworkType:       squad.NetworkType,
+		MeshLimit:         int32(squad.MeshLimit),
+	}
+}
@@ -0,0 +1,143 @@
+package manager
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const HEARTBEAT string = "heartbeat"
+
+const (
+	DEFAULT_PING_INTERVAL       = 30 * time.Second
+	DEFAULT_PONG_TIMEOUT        = 15 * time.Second
+	DEFAULT_EVICT_AFTER         = 2 * time.Minute
+	DEFAULT_GRPC_KEEPALIVE_TIME = time.Minute
+	DEFAULT_GRPC_KEEPALIVE_WAIT = 20 * time.Second
+	DEFAULT_GRPC_KEEPALIVE_MIN  = 30 * time.Second
+)
+
+func (heartbeat HeartbeatConfig) sleepAfter() time.Duration {
+	return time.Duration(heartbeat.PingInterval) + time.Duration(heartbeat.PongTimeout)
+}
+
+func touch(lastSeen *int64) {
+	atomic.StoreInt64(lastSeen, time.Now().UnixNano())
+}
+
+func idle(lastSeen *int64, now time.Time) time.Duration {
+	return now.Sub(time.Unix(0, atomic.LoadInt64(lastSeen)))
+}
+
+func (peer *GRPCPeer) touch() {
+	touch(&peer.lastSeen)
+}
+
+func (peer *WSPeer) touch() {
+	touch(&peer.lastSeen)
+}
+
+func (peer *GRPCPeer) heartbeat(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := peer.Send(&Envelope{Type: HEARTBEAT}); err != nil {
+				log.Println(err)
+			}
+		case <-peer.outbox.closing:
+			return
+		}
+	}
+}
+
+func (peer *WSPeer) ping(interval time.Duration, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := peer.Conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(timeout)); err != nil {
+				log.Println(err)
+				return
+			}
+		case <-peer.outbox.done:
+			return
+		}
+	}
+}
+
+func (router *Router) reap(now time.Time) (evicted []connectedPeer) {
+	router.Lock()
+	defer router.Unlock()
+	sleepAfter, evictAfter := router.Heartbeat.sleepAfter(), time.Duration(router.Heartbeat.EvictAfter)
+	for id, peer := range router.GRPCPeers {
+		switch d := idle(&peer.lastSeen, now); {
+		case d >= evictAfter:
+			evicted = append(evicted, connectedPeer{id, peer})
+		case d >= sleepAfter:
+			peer.State = SLEEP
+		default:
+			peer.State = CONNECTED
+		}
+	}
+	for id, peer := range router.WSPeers {
+		switch d := idle(&peer.lastSeen, now); {
+		case d >= evictAfter:
+			evicted = append(evicted, connectedPeer{id, peer})
+		case d >= sleepAfter:
+			peer.State = WS_SLEEP
+		default:
+			peer.State = WS_OPEN
+		}
+	}
+	return
+}
+
+func (manager *Manager) Reap(now time.Time) {
+	for _, peer := range manager.Router.reap(now) {
+		log.Printf("evicting idle peer %s\n", peer.id)
+		manager.dropConnection(peer.id, peer.conn)
+		if !manager.Router.Connected(peer.id) {
+			manager.expireSession(peer.id)
+		}
+	}
+}
+
+func (manager *Manager) StartReaper(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(manager.Router.Heartbeat.PingInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			manager.Reap(now)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (manager *Manager) leaveSquads(peerId string) {
+	squadIds := []string{}
+	for _, store := range []SquadStore{manager.SquadStore, manager.HostedSquadStore} {
+		squads, err := store.GetSquadsByMember(context.Background(), peerId, 0, 0)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		for _, squad := range squads {
+			if containsPeer(squad.Members, peerId) {
+				squadIds = append(squadIds, squad.ID)
+			}
+		}
+	}
+	for _, squadId := range squadIds {
+		if err := manager.leaveSquad(squadId, peerId); err != nil {
+			log.Println(err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package manager
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReaperEvictsSilentPeers(t *testing.T) {
+	m := NewMemoryManager()
+	m.Router.Heartbeat = HeartbeatConfig{
+		PingInterval: Duration(20 * time.Millisecond),
+		PongTimeout:  Duration(time.Minute),
+		EvictAfter:   Duration(time.Hour),
+	}
+	if err := m.SquadStore.AddNewSquad(context.Background(), &Squad{ID: "squad", Owner: "lolo", Members: []string{"lolo", "lolo2"}, NetworkType: MESH}); err != nil {
+		t.Fatal(err)
+	}
+	silent, cancelSilent := linkTestPeer(t, m, "lolo")
+	defer cancelSilent()
+	stream, cancel := linkTestPeer(t, m, "lolo2")
+	defer cancel()
+	select {
+	case res := <-silent.sent:
+		if res.Type != HEARTBEAT {
+			t.Errorf("expected a heartbeat, got %v", res)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("lolo never received a heartbeat")
+	}
+	m.Router.RLock()
+	peer := m.Router.GRPCPeers["lolo"]
+	m.Router.RUnlock()
+	atomic.StoreInt64(&peer.lastSeen, time.Now().Add(-2*time.Minute).UnixNano())
+	m.Reap(time.Now())
+	m.Router.RLock()
+	if peer.State != SLEEP || m.Router.GRPCPeers["lolo2"].State != CONNECTED {
+		t.Error("expected only the silent peer to be marked asleep")
+	}
+	m.Router.RUnlock()
+	atomic.StoreInt64(&peer.lastSeen, time.Now().Add(-2*time.Hour).UnixNano())
+	m.Reap(time.Now())
+	if m.Router.Connected("lolo") {
+		t.Fatal("expected the silent peer to be evicted")
+	}
+	deadline := time.After(time.Second)
+	for {
+		select {
+		case res := <-stream.sent:
+			if res.Type == string(LEAVING_MEMBER) {
+				if res.Payload["id"] != "lolo" {
+					t.Errorf("expected lolo to leave the squad, got %v", res)
+				}
+				squad, err := m.SquadStore.GetSquad(context.Background(), "squad")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if containsPeer(squad.Members, "lolo") {
+					t.Errorf("expected lolo to be removed from the squad members, got %v", squad.Members)
+				}
+				return
+			}
+		case <-deadline:
+			t.Fatal("lolo2 was not told that lolo left the squad")
+		}
+	}
+}
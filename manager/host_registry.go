@@ -0,0 +1,238 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+const SQUAD_HOST_CHANGED SquadEvent = "squad_host_changed"
+
+var ErrNoHost = errors.New("no host is available for hosted squads")
+
+type HostRegistry struct {
+	hosts    map[string]*Host
+	reserved map[string]map[string]bool
+	*sync.RWMutex
+}
+
+func NewHostRegistry() *HostRegistry {
+	return &HostRegistry{
+		hosts:    make(map[string]*Host),
+		reserved: make(map[string]map[string]bool),
+		RWMutex:  &sync.RWMutex{},
+	}
+}
+
+func copyHost(host *Host) *Host {
+	return &Host{
+		Id:        host.Id,
+		Region:    host.Region,
+		Capacity:  host.Capacity,
+		Load:      host.Load,
+		UpdatedAt: host.UpdatedAt,
+	}
+}
+
+func (hr *HostRegistry) set(host *Host) {
+	hr.Lock()
+	defer hr.Unlock()
+	host.Load += int32(len(hr.reserved[host.Id]))
+	hr.hosts[host.Id] = copyHost(host)
+}
+
+func (hr *HostRegistry) remove(hostId string) (host *Host, ok bool) {
+	hr.Lock()
+	defer hr.Unlock()
+	if host, ok = hr.hosts[hostId]; ok {
+		delete(hr.hosts, hostId)
+		delete(hr.reserved, hostId)
+	}
+	return
+}
+
+func (hr *HostRegistry) list(region string) (hosts []*Host) {
+	hr.RLock()
+	defer hr.RUnlock()
+	hosts = hr.listLocked(region)
+	return
+}
+
+func (hr *HostRegistry) listLocked(region string) (hosts []*Host) {
+	hosts = make([]*Host, 0, len(hr.hosts))
+	for _, host := range hr.hosts {
+		if region == "" || host.Region == region {
+			hosts = append(hosts, copyHost(host))
+		}
+	}
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].Id < hosts[j].Id })
+	return
+}
+
+func (hr *HostRegistry) reserve(hostId string, squadId string) {
+	hr.Lock()
+	defer hr.Unlock()
+	hr.reserveLocked(hostId, squadId)
+}
+
+func (hr *HostRegistry) reserveLocked(hostId string, squadId string) {
+	host, ok := hr.hosts[hostId]
+	if !ok || hr.reserved[hostId][squadId] {
+		return
+	}
+	if hr.reserved[hostId] == nil {
+		hr.reserved[hostId] = make(map[string]bool)
+	}
+	hr.reserved[hostId][squadId] = true
+	host.Load++
+}
+
+func (hr *HostRegistry) release(hostId string, squadId string) {
+	hr.Lock()
+	defer hr.Unlock()
+	if !hr.reserved[hostId][squadId] {
+		return
+	}
+	delete(hr.reserved[hostId], squadId)
+	if host, ok := hr.hosts[hostId]; ok && host.Load > 0 {
+		host.Load--
+	}
+}
+
+func (hr *HostRegistry) elect(region string, exclude string) (host *Host, ok bool) {
+	hr.RLock()
+	defer hr.RUnlock()
+	host, ok = hr.electLocked(region, exclude)
+	return
+}
+
+func (hr *HostRegistry) claim(region string, exclude string, squadId string) (host *Host, ok bool) {
+	hr.Lock()
+	defer hr.Unlock()
+	if host, ok = hr.electLocked(region, exclude); ok {
+		hr.reserveLocked(host.Id, squadId)
+		host = copyHost(hr.hosts[host.Id])
+	}
+	return
+}
+
+func (hr *HostRegistry) electLocked(region string, exclude string) (host *Host, ok bool) {
+	for _, candidate := range hr.listLocked("") {
+		if candidate.Id == exclude || candidate.Load >= candidate.Capacity {
+			continue
+		}
+		if host == nil || betterHost(candidate, host, region) {
+			host = candidate
+		}
+	}
+	ok = host != nil
+	return
+}
+
+func betterHost(candidate *Host, current *Host, region string) bool {
+	if region != "" && (candidate.Region == region) != (current.Region == region) {
+		return candidate.Region == region
+	}
+	return int64(candidate.Load)*int64(current.Capacity) < int64(current.Load)*int64(candidate.Capacity)
+}
+
+func (manager *Manager) RegisterHost(token string, peerId string, region string, capacity int32, load int32) (host *Host, err error) {
+	if err = manager.authenticate(token, peerId); err != nil {
+		return
+	}
+	if capacity <= 0 {
+		err = fmt.Errorf("a host needs a positive capacity")
+		return
+	}
+	if load < 0 {
+		err = fmt.Errorf("a host cannot have a negative load")
+		return
+	}
+	if !manager.Router.Connected(peerId) {
+		err = fmt.Errorf("peer %s must be linked to register as a host", peerId)
+		return
+	}
+	host = &Host{
+		Id:        peerId,
+		Region:    region,
+		Capacity:  capacity,
+		Load:      load,
+		UpdatedAt: time.Now().Unix(),
+	}
+	manager.Hosts.set(host)
+	manager.reassignSquads("", "")
+	return
+}
+
+func (manager *Manager) UnregisterHost(token string, peerId string) (err error) {
+	if err = manager.authenticate(token, peerId); err != nil {
+		return
+	}
+	if !manager.hostLost(peerId) {
+		err = fmt.Errorf("peer %s is not a registered host", peerId)
+	}
+	return
+}
+
+func (manager *Manager) ListHosts(token string, peerId string, region string) (hosts []*Host, err error) {
+	if err = manager.authenticate(token, peerId); err != nil {
+		return
+	}
+	hosts = manager.Hosts.list(region)
+	return
+}
+
+func (manager *Manager) ElectHost(region string, squadId string) (hostId string, err error) {
+	host, ok := manager.Hosts.claim(region, "", squadId)
+	if !ok {
+		err = ErrNoHost
+		return
+	}
+	hostId = host.Id
+	return
+}
+
+func (manager *Manager) hostLost(hostId string) bool {
+	host, ok := manager.Hosts.remove(hostId)
+	if ok {
+		manager.reassignSquads(hostId, host.Region)
+	}
+	return ok
+}
+
+func (manager *Manager) reassignSquads(hostId string, region string) {
+	squads, err := manager.HostedSquadStore.GetSquadsByHost(context.Background(), hostId, 0, 0)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, squad := range squads {
+		newHost := ""
+		if host, ok := manager.Hosts.claim(region, hostId, squad.ID); ok {
+			newHost = host.Id
+		}
+		if newHost == hostId {
+			continue
+		}
+		if err = manager.HostedSquadStore.UpdateSquadHost(context.Background(), squad.ID, newHost); err != nil {
+			log.Println(err)
+			manager.Hosts.release(newHost, squad.ID)
+			continue
+		}
+		manager.SignalGrants.revokeSquad(squad.ID)
+		manager.Lock()
+		if s, ok := manager.Squads[squad.ID]; ok {
+			s.HostId = newHost
+		}
+		manager.Unlock()
+		recipients := squad.Members
+		if newHost != "" && !containsPeer(recipients, newHost) {
+			recipients = append(recipients, newHost)
+		}
+		manager.notifySquad(recipients, hostId, SQUAD_HOST_CHANGED, map[string]string{"squadId": squad.ID, "hostId": newHost})
+	}
+}
@@ -0,0 +1,146 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func expectHostChange(t *testing.T, stream *testLinkStream, squadId string, hostId string) {
+	t.Helper()
+	select {
+	case res := <-stream.sent:
+		if res.Type != string(SQUAD_HOST_CHANGED) || res.Payload["squadId"] != squadId || res.Payload["hostId"] != hostId {
+			t.Errorf("expected squad %s to be hosted by %q, got %v", squadId, hostId, res)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("expected squad %s to be hosted by %q, got nothing", squadId, hostId)
+	}
+}
+
+func TestHostElection(t *testing.T) {
+	m := NewMemoryManager()
+	m.Resume.Config.GracePeriod = Duration(10 * time.Millisecond)
+	tokens := map[string]string{}
+	for _, peer := range []string{"lolo", "lolo2", "host1", "host2", "host3"} {
+		tokens[peer] = newTestSession(t, m, peer)
+	}
+	if err := m.CreateSquad(tokens["lolo"], "0xff", "lolo", "test squad", PUBLIC, "", HOSTED, ""); err == nil {
+		t.Error("expected a hosted squad to need an available host")
+	}
+	if _, err := m.RegisterHost(tokens["host1"], "host1", "eu", 2, 0); err == nil {
+		t.Error("expected an unlinked peer to be unable to register as a host")
+	}
+	host1, cancel1 := linkTestPeer(t, m, "host1")
+	defer cancel1()
+	host2, cancel2 := linkTestPeer(t, m, "host2")
+	defer cancel2()
+	if _, err := m.RegisterHost(tokens["host1"], "host1", "eu", 0, 0); err == nil {
+		t.Error("expected a host without capacity to be rejected")
+	}
+	if _, err := m.RegisterHost(tokens["host1"], "host1", "eu", 2, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.RegisterHost(tokens["host2"], "host2", "us", 4, 0); err != nil {
+		t.Fatal(err)
+	}
+	if host, _ := m.Hosts.elect("eu", ""); host == nil || host.Id != "host1" {
+		t.Errorf("expected the host of the requested region to be elected, got %v", host)
+	}
+	if err := m.CreateSquad(tokens["lolo"], "0xff", "lolo", "test squad", PUBLIC, "", HOSTED, ""); err != nil {
+		t.Fatal(err)
+	}
+	expectHostChange(t, host2, "0xff", "host2")
+	member, cancel := linkTestPeer(t, m, "lolo2")
+	defer cancel()
+	if err := m.ConnectToSquad(tokens["lolo2"], "0xff", "lolo2", "", "", HOSTED); err != nil {
+		t.Fatal(err)
+	}
+	unlinkTestPeer(t, m, "host2", cancel2)
+	expectHostChange(t, member, "0xff", "host1")
+	expectHostChange(t, host1, "0xff", "host1")
+	hosts, err := m.ListHosts(tokens["lolo"], "lolo", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) != 1 || hosts[0].Id != "host1" || hosts[0].Load != 2 {
+		t.Errorf("expected host1 to be the only host left and to be full, got %v", hosts)
+	}
+	if err = m.UnregisterHost(tokens["host1"], "host1"); err != nil {
+		t.Fatal(err)
+	}
+	expectHostChange(t, member, "0xff", "")
+	host3, cancel3 := linkTestPeer(t, m, "host3")
+	defer cancel3()
+	if _, err = m.RegisterHost(tokens["host3"], "host3", "", 1, 0); err != nil {
+		t.Fatal(err)
+	}
+	expectHostChange(t, host3, "0xff", "host3")
+	expectHostChange(t, member, "0xff", "host3")
+	squad, err := m.HostedSquadStore.GetSquad(context.Background(), "0xff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if squad.HostId != "host3" {
+		t.Errorf("expected the orphaned squad to be adopted by host3, got %q", squad.HostId)
+	}
+}
+
+func TestHostReservations(t *testing.T) {
+	m := NewMemoryManager()
+	tokens := map[string]string{}
+	for _, peer := range []string{"lolo", "host1"} {
+		tokens[peer] = newTestSession(t, m, peer)
+	}
+	_, cancel := linkTestPeer(t, m, "host1")
+	defer cancel()
+	if _, err := m.RegisterHost(tokens["host1"], "host1", "eu", 3, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.createSquad("forged", "0xfe", "lolo", "test squad", PUBLIC, "", HOSTED, "", "eu"); err == nil {
+		t.Error("expected an unauthenticated peer to be unable to create a squad")
+	}
+	if hosts := m.Hosts.list(""); hosts[0].Load != 0 {
+		t.Errorf("expected a refused creation to leave no reservation, got %v", hosts)
+	}
+	if err := m.CreateSquad(tokens["lolo"], "0xfd", "lolo", "test squad", PUBLIC, "", HOSTED, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.DeleteSquad(tokens["lolo"], "0xfd", "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	if hosts := m.Hosts.list(""); hosts[0].Load != 0 {
+		t.Errorf("expected a deleted squad to release its host, got %v", hosts)
+	}
+	hostId, err := m.ElectHost("eu", "0xff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.CreateSquad(tokens["lolo"], "0xff", "lolo", "test squad", PUBLIC, "", HOSTED, hostId); err != nil {
+		t.Fatal(err)
+	}
+	host, err := m.RegisterHost(tokens["host1"], "host1", "eu", 3, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host.Load != 2 {
+		t.Errorf("expected the squad elected then created on host1 to be counted once on top of the reported load, got %d", host.Load)
+	}
+	claimed := make(chan bool, 8)
+	for i := 0; i < cap(claimed); i++ {
+		go func(i int) {
+			_, ok := m.Hosts.claim("", "", fmt.Sprintf("0x%d", i))
+			claimed <- ok
+		}(i)
+	}
+	won := 0
+	for i := 0; i < cap(claimed); i++ {
+		if <-claimed {
+			won++
+		}
+	}
+	if hosts := m.Hosts.list(""); won != 1 || hosts[0].Load != 3 {
+		t.Errorf("expected concurrent elections to fill host1 exactly, got %d elections and %v", won, hosts)
+	}
+}
@@ -0,0 +1,136 @@
+package manager
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const ICE_SERVERS = "ice_servers"
+
+const DEFAULT_TURN_CREDENTIAL_TTL = 12 * time.Hour
+
+var ErrNoICEServers = errors.New("no ice servers are configured on this server")
+
+type ICEProvider struct {
+	Config ICEConfig
+	timers map[string]*time.Timer
+	*sync.Mutex
+}
+
+func NewICEProvider(config ICEConfig) *ICEProvider {
+	return &ICEProvider{
+		Config: config,
+		timers: make(map[string]*time.Timer),
+		Mutex:  &sync.Mutex{},
+	}
+}
+
+func isTURNURL(url string) bool {
+	return strings.HasPrefix(url, "turn:") || strings.HasPrefix(url, "turns:")
+}
+
+func turnCredential(secret string, peerId string, expiresAt time.Time) (username string, credential string) {
+	username = strconv.FormatInt(expiresAt.Unix(), 10) + ":" + peerId
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write([]byte(username))
+	credential = base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return
+}
+
+func (ip *ICEProvider) enabled() bool {
+	return len(ip.Config.Servers) > 0
+}
+
+func (ip *ICEProvider) servers(peerId string, region string, now time.Time) (servers []*ICEServer, expiresAt time.Time) {
+	expiresAt = now.Add(time.Duration(ip.Config.CredentialTTL))
+	username, credential := turnCredential(ip.Config.TURNSecret, peerId, expiresAt)
+	for _, server := range ip.Config.Servers {
+		if server.Region != "" && server.Region != region {
+			continue
+		}
+		var stun, turn []string
+		for _, url := range server.URLs {
+			if isTURNURL(url) {
+				turn = append(turn, url)
+			} else {
+				stun = append(stun, url)
+			}
+		}
+		if len(stun) > 0 {
+			servers = append(servers, &ICEServer{Urls: stun})
+		}
+		if len(turn) > 0 {
+			servers = append(servers, &ICEServer{Urls: turn, Username: username, Credential: credential})
+		}
+	}
+	return
+}
+
+func (ip *ICEProvider) schedule(peerId string, refresh func()) {
+	ip.Lock()
+	defer ip.Unlock()
+	if timer, ok := ip.timers[peerId]; ok {
+		timer.Stop()
+	}
+	ttl := time.Duration(ip.Config.CredentialTTL)
+	ip.timers[peerId] = time.AfterFunc(ttl-ttl/4, refresh)
+}
+
+func (ip *ICEProvider) stop(peerId string) {
+	ip.Lock()
+	defer ip.Unlock()
+	if timer, ok := ip.timers[peerId]; ok {
+		timer.Stop()
+		delete(ip.timers, peerId)
+	}
+}
+
+func (manager *Manager) GetICEServers(token string, peerId string, region string) (servers []*ICEServer, expiresAt time.Time, err error) {
+	if err = manager.authenticate(token, peerId); err != nil {
+		return
+	}
+	if !manager.ICE.enabled() {
+		err = ErrNoICEServers
+		return
+	}
+	servers, expiresAt = manager.ICE.servers(peerId, region, time.Now())
+	return
+}
+
+func (manager *Manager) pushICEServers(peerId string, region string) {
+	if !manager.ICE.enabled() {
+		return
+	}
+	servers, expiresAt := manager.ICE.servers(peerId, region, time.Now())
+	data, err := json.Marshal(servers)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if err = manager.send(&Envelope{
+		Type: ICE_SERVERS,
+		To:   peerId,
+		Payload: map[string]string{
+			"iceServers": string(data),
+			"expiresAt":  strconv.FormatInt(expiresAt.Unix(), 10),
+		},
+	}); err != nil {
+		log.Println(err)
+		return
+	}
+	manager.ICE.schedule(peerId, func() {
+		if manager.stopped() || !manager.Router.Connected(peerId) {
+			manager.ICE.stop(peerId)
+			return
+		}
+		manager.pushICEServers(peerId, region)
+	})
+}
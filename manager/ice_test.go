@@ -0,0 +1,63 @@
+package manager
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestICEServers(t *testing.T) {
+	m := NewMemoryManager()
+	token := newTestSession(t, m, "lolo")
+	if _, _, err := m.GetICEServers(token, "lolo", ""); err != ErrNoICEServers {
+		t.Errorf("expected no ice servers without configuration, got %v", err)
+	}
+	m.ICE.Config = ICEConfig{
+		Servers: []ICEServerConfig{
+			{URLs: []string{"stun:stun.zippytal.com:3478"}},
+			{URLs: []string{"stun:eu.zippytal.com:3478", "turn:eu.zippytal.com:3478?transport=udp"}, Region: "eu"},
+			{URLs: []string{"turns:us.zippytal.com:5349"}, Region: "us"},
+		},
+		TURNSecret:    "secret",
+		CredentialTTL: Duration(100 * time.Millisecond),
+	}
+	if _, _, err := m.GetICEServers("forged", "lolo", "eu"); err == nil {
+		t.Error("expected an unauthenticated peer to be refused ice servers")
+	}
+	servers, expiresAt, err := m.GetICEServers(token, "lolo", "eu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servers) != 3 || servers[2].Urls[0] != "turn:eu.zippytal.com:3478?transport=udp" {
+		t.Fatalf("expected the global stun server and the eu servers, got %v", servers)
+	}
+	if servers[0].Username != "" || servers[1].Credential != "" {
+		t.Error("expected stun servers to come without credentials")
+	}
+	parts := strings.SplitN(servers[2].Username, ":", 2)
+	if len(parts) != 2 || parts[0] != strconv.FormatInt(expiresAt.Unix(), 10) || parts[1] != "lolo" {
+		t.Errorf("expected the turn username to be expiry:peerId, got %s", servers[2].Username)
+	}
+	mac := hmac.New(sha1.New, []byte("secret"))
+	mac.Write([]byte(servers[2].Username))
+	if servers[2].Credential != base64.StdEncoding.EncodeToString(mac.Sum(nil)) {
+		t.Error("expected the turn credential to be signed with the shared secret")
+	}
+	stream, cancel := linkTestPeer(t, m, "lolo")
+	defer cancel()
+	for i := 0; i < 2; i++ {
+		res := nextEvent(t, stream, ICE_SERVERS)
+		pushed := []*ICEServer{}
+		if err = json.Unmarshal([]byte(res.Payload["iceServers"]), &pushed); err != nil {
+			t.Fatal(err)
+		}
+		if len(pushed) != 1 || pushed[0].Urls[0] != "stun:stun.zippytal.com:3478" {
+			t.Errorf("expected a peer without region to get the global servers only, got %v", pushed)
+		}
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -18,24 +20,39 @@ type (
 	SquadEvent    string
 
 	GRPCPeer struct {
-		Conn  GrpcManager_LinkServer
-		State GRPCPeerState
+		Conn     GrpcManager_LinkServer
+		State    GRPCPeerState
+		outbox   *OutboundQueue
+		lastSeen int64
 	}
 
 	WSPeer struct {
-		Conn  *websocket.Conn
-		State WSState
+		Conn     *websocket.Conn
+		State    WSState
+		outbox   *OutboundQueue
+		lastSeen int64
 	}
 
 	Manager struct {
-		State                ManagerState
-		GRPCPeers            map[string]*GRPCPeer
-		WSPeers              map[string]*WSPeer
-		Squads               map[string]*Squad
-		SquadDBManager       *SquadDBManager
-		HostedSquadDBManager *HostedSquadDBManager
-		PeerDBManager        *PeerDBManager
-		AuthManager          *AuthManager
+		State             ManagerState
+		Router            *Router
+		Squads            map[string]*Squad
+		SquadStore        SquadStore
+		HostedSquadStore  SquadStore
+		PeerStore         PeerStore
+		MessageStore      MessageStore
+		OfflineMessageTTL time.Duration
+		OfflineMessageCap int
+		AuthManager       *AuthManager
+		Presence          *PresenceTracker
+		Blocks            *BlockList
+		Resume            *ResumeTracker
+		Cluster           *Cluster
+		Hosts             *HostRegistry
+		Mesh              *MeshCoordinator
+		ICE               *ICEProvider
+		Signaling         SignalingConfig
+		SignalGrants      *SignalGrants
 		*sync.RWMutex
 	}
 )
@@ -50,11 +67,16 @@ const (
 	HOSTED_INCOMING_MEMBER SquadEvent = "hosted_incoming_member"
 	LEAVING_MEMBER         SquadEvent = "leaving_member"
 	HOSTED_LEAVING_MEMBER  SquadEvent = "hosted_leaving_member"
+	KICKED_MEMBER          SquadEvent = "kicked_member"
+	BANNED_MEMBER          SquadEvent = "banned_member"
+	SQUAD_ROLE_CHANGED     SquadEvent = "squad_role_changed"
+	SQUAD_OWNER_CHANGED    SquadEvent = "squad_owner_changed"
 )
 
 const (
 	ON ManagerState = iota
 	OFF
+	DRAINING
 )
 
 const (
@@ -64,98 +86,391 @@ const (
 
 const DB_NAME string = "zippytal_server"
 
-func NewManager() (manager *Manager, err error) {
-	hostedSquadDBManager, err := NewHostedSquadDBManager("localhost", 27017)
+const DEFAULT_PEER_KEY_ID = "default"
+
+func NewManager(squadStore SquadStore, hostedSquadStore SquadStore, peerStore PeerStore, authManager *AuthManager) (manager *Manager) {
+	manager = &Manager{
+		State:             ON,
+		Router:            NewRouter(),
+		Squads:            make(map[string]*Squad),
+		SquadStore:        squadStore,
+		HostedSquadStore:  hostedSquadStore,
+		PeerStore:         peerStore,
+		MessageStore:      NewMemoryMessageStore(),
+		OfflineMessageTTL: DEFAULT_OFFLINE_MESSAGE_TTL,
+		OfflineMessageCap: DEFAULT_OFFLINE_MAX_MESSAGES,
+		RWMutex:           &sync.RWMutex{},
+		AuthManager:       authManager,
+		Presence:          NewPresenceTracker(),
+		Blocks:            NewBlockList(),
+		Resume:            NewResumeTracker(DefaultConfig().Resume),
+		Hosts:             NewHostRegistry(),
+		Mesh:              NewMeshCoordinator(DefaultConfig().Mesh),
+		ICE:               NewICEProvider(DefaultConfig().ICE),
+		Signaling:         DefaultConfig().Signaling,
+		SignalGrants:      NewSignalGrants(),
+	}
+	return
+}
+
+func NewMemoryManager() (manager *Manager) {
+	manager = NewManager(NewMemorySquadStore(), NewMemorySquadStore(), NewMemoryPeerStore(), NewAuthManager(DEFAULT_SESSION_TTL, nil))
+	return
+}
+
+func NewManagerFromConfig(config *Config) (manager *Manager, err error) {
+	var squadStore, hostedSquadStore SquadStore
+	var peerStore PeerStore
+	var sessionStore SessionStore
+	var messageStore MessageStore
+	switch config.Database.Backend {
+	case MONGO_BACKEND:
+		if squadStore, err = NewSquadDBManager(config.Database.URI, config.Database.Name); err != nil {
+			return
+		}
+		if hostedSquadStore, err = NewHostedSquadDBManager(config.Database.URI, config.Database.Name); err != nil {
+			return
+		}
+		if peerStore, err = NewPeerDBManager(config.Database.URI, config.Database.Name); err != nil {
+			return
+		}
+		if config.Auth.PersistSessions {
+			if sessionStore, err = NewSessionDBManager(config.Database.URI, config.Database.Name); err != nil {
+				return
+			}
+		}
+		if messageStore, err = NewMessageDBManager(config.Database.URI, config.Database.Name); err != nil {
+			return
+		}
+	case MEMORY_BACKEND:
+		squadStore, hostedSquadStore, peerStore = NewMemorySquadStore(), NewMemorySquadStore(), NewMemoryPeerStore()
+		messageStore = NewMemoryMessageStore()
+		if config.Auth.PersistSessions {
+			sessionStore = NewMemorySessionStore()
+		}
+	default:
+		err = fmt.Errorf("unknown database backend %s", config.Database.Backend)
+		return
+	}
+	authManager := NewAuthManager(time.Duration(config.Auth.SessionTTL), sessionStore)
+	if authManager.TokenSigner, err = NewTokenSignerFromConfig(config.Auth); err != nil {
+		return
+	}
+	if err = authManager.LoadSessions(context.Background()); err != nil {
+		return
+	}
+	manager = NewManager(squadStore, hostedSquadStore, peerStore, authManager)
+	manager.Router.Outbound, manager.Router.Heartbeat = config.Outbound, config.Heartbeat
+	manager.Resume, manager.Mesh = NewResumeTracker(config.Resume), NewMeshCoordinator(config.Mesh)
+	manager.ICE, manager.Signaling = NewICEProvider(config.ICE), config.Signaling
+	manager.MessageStore, manager.OfflineMessageTTL = messageStore, time.Duration(config.Offline.MessageTTL)
+	manager.OfflineMessageCap = config.Offline.MaxMessages
+	cluster, err := NewClusterFromConfig(config.Cluster)
+	if err != nil || cluster == nil {
+		return
+	}
+	err = manager.JoinCluster(cluster)
+	return
+}
+
+func (manager *Manager) squadStoreFor(networkType SquadNetworkType) (store SquadStore, err error) {
+	switch networkType {
+	case MESH:
+		store = manager.SquadStore
+	case HOSTED:
+		store = manager.HostedSquadStore
+	default:
+		err = fmt.Errorf("unknown squad network type %s", networkType)
+	}
+	return
+}
+
+func (manager *Manager) CreatePeer(peerId string, peerKey string, peerUsername string) (err error) {
+	_, algorithm, err := ParsePeerKey(peerKey)
 	if err != nil {
 		return
 	}
-	squadDBManager, err := NewSquadDBManager("localhost", 27017)
+	peer := &Peer{
+		PubKey:       peerKey,
+		Id:           peerId,
+		Name:         peerUsername,
+		KeyAlgorithm: string(algorithm),
+		Keys: []*PeerKey{{
+			Id:           DEFAULT_PEER_KEY_ID,
+			Label:        DEFAULT_PEER_KEY_ID,
+			PubKey:       peerKey,
+			KeyAlgorithm: string(algorithm),
+			CreatedAt:    time.Now().Unix(),
+		}},
+	}
+	err = manager.PeerStore.AddNewPeer(context.Background(), peer)
+	return
+}
+
+func (manager *Manager) PeerAuthInit(peerId string, keyId string) (challenge []byte, authType AuthType, err error) {
+	if manager.AuthManager.PendingChallenge(peerId, keyId) {
+		err = fmt.Errorf("user in authentification")
+		return
+	}
+	peer, err := manager.PeerStore.GetPeer(context.Background(), peerId)
 	if err != nil {
 		return
 	}
-	peerDBManager, err := NewPeerDBManager("localhost", 27017)
+	key, err := activePeerKey(peer, keyId)
 	if err != nil {
 		return
 	}
-	manager = &Manager{
-		State:                ON,
-		GRPCPeers:            make(map[string]*GRPCPeer),
-		WSPeers:              make(map[string]*WSPeer),
-		Squads:               make(map[string]*Squad),
-		SquadDBManager:       squadDBManager,
-		HostedSquadDBManager: hostedSquadDBManager,
-		PeerDBManager:        peerDBManager,
-		RWMutex:              &sync.RWMutex{},
-		AuthManager:          NewAuthManager(),
+	challenge, authType, err = manager.AuthManager.GenerateChallenge(peer.Id, keyId, key.PubKey)
+	return
+}
+
+func (manager *Manager) PeerAuthVerif(peerId string, keyId string, response []byte) (session *Session, accessToken string, err error) {
+	token, err := manager.AuthManager.VerifyChallenge(peerId, keyId, response)
+	if err != nil {
+		return
+	}
+	key, err := manager.touchPeerKey(peerId, keyId)
+	if err != nil {
+		return
+	}
+	if session, err = manager.AuthManager.IssueSession(peerId, key.Id, token); err != nil {
+		return
+	}
+	if manager.AuthManager.TokenSigner != nil {
+		accessToken, _, err = manager.AuthManager.TokenSigner.Sign(peerId, nil)
 	}
 	return
 }
 
-func (manager *Manager) CreatePeer(peerId string, peerKey string, peerUsername string) (err error) {
-	peer := &Peer{
-		PubKey: peerKey,
-		Id:     peerId,
-		Name:   peerUsername,
+func (manager *Manager) AddPeerKey(token string, peerId string, label string, peerKey string) (key *PeerKey, err error) {
+	if err = manager.authenticate(token, peerId); err != nil {
+		return
+	}
+	_, algorithm, err := ParsePeerKey(peerKey)
+	if err != nil {
+		return
+	}
+	manager.Lock()
+	defer manager.Unlock()
+	peer, err := manager.PeerStore.GetPeer(context.Background(), peerId)
+	if err != nil {
+		return
 	}
-	err = manager.PeerDBManager.AddNewPeer(context.Background(), peer)
+	keys := peerKeys(peer)
+	for _, k := range keys {
+		if k.PubKey == peerKey {
+			err = fmt.Errorf("this key is already registered as %s", k.Id)
+			return
+		}
+	}
+	key = &PeerKey{
+		Id:           uuid.NewString(),
+		Label:        label,
+		PubKey:       peerKey,
+		KeyAlgorithm: string(algorithm),
+		CreatedAt:    time.Now().Unix(),
+	}
+	err = manager.PeerStore.UpdatePeerKeys(context.Background(), peerId, append(keys, key))
 	return
 }
 
-func (manager *Manager) PeerAuthInit(peerId string) (encryptedToken []byte, err error) {
-	if _, ok := manager.AuthManager.AuthTokenPending[peerId]; ok {
-		err = fmt.Errorf("user in authentification")
+func (manager *Manager) ListPeerKeys(token string, peerId string) (keys []*PeerKey, err error) {
+	if err = manager.authenticate(token, peerId); err != nil {
+		return
+	}
+	peer, err := manager.PeerStore.GetPeer(context.Background(), peerId)
+	if err != nil {
+		return
+	}
+	keys = peerKeys(peer)
+	return
+}
+
+func (manager *Manager) RevokePeerKey(token string, peerId string, keyId string) (err error) {
+	if err = manager.authenticate(token, peerId); err != nil {
+		return
+	}
+	manager.Lock()
+	peer, err := manager.PeerStore.GetPeer(context.Background(), peerId)
+	if err != nil {
+		manager.Unlock()
+		return
+	}
+	keys, active := peerKeys(peer), 0
+	var revoked *PeerKey
+	for _, k := range keys {
+		if k.Revoked {
+			continue
+		}
+		if k.Id == keyId {
+			revoked = k
+		} else {
+			active++
+		}
+	}
+	if revoked == nil {
+		manager.Unlock()
+		err = fmt.Errorf("no active key %s for peer %s", keyId, peerId)
+		return
+	}
+	if active == 0 {
+		manager.Unlock()
+		err = fmt.Errorf("cannot revoke the last active key of %s", peerId)
 		return
 	}
-	peer, err := manager.PeerDBManager.GetPeer(context.Background(), peerId)
+	revoked.Revoked = true
+	err = manager.PeerStore.UpdatePeerKeys(context.Background(), peerId, keys)
+	manager.Unlock()
 	if err != nil {
-		delete(manager.AuthManager.AuthTokenPending, peerId)
 		return
 	}
-	encryptedToken, err = manager.AuthManager.GenerateAuthToken(peer.Id, peer.PubKey)
+	err = manager.AuthManager.RevokeKeySessions(peerId, keyId)
+	return
+}
+
+func (manager *Manager) touchPeerKey(peerId string, keyId string) (key *PeerKey, err error) {
+	manager.Lock()
+	defer manager.Unlock()
+	peer, err := manager.PeerStore.GetPeer(context.Background(), peerId)
 	if err != nil {
-		delete(manager.AuthManager.AuthTokenPending, peerId)
+		return
+	}
+	if key, err = activePeerKey(peer, keyId); err != nil {
+		return
+	}
+	key.LastUsedAt = time.Now().Unix()
+	if len(peer.Keys) > 0 {
+		err = manager.PeerStore.UpdatePeerKeys(context.Background(), peerId, peer.Keys)
 	}
 	return
 }
 
-func (manager *Manager) PeerAuthVerif(peerId string, token []byte) (err error) {
-	if _, ok := manager.AuthManager.AuthTokenPending[peerId]; !ok {
-		err = fmt.Errorf("the peer %s have not initiated auth", peerId)
+func peerKeys(peer *Peer) []*PeerKey {
+	if len(peer.Keys) > 0 || peer.PubKey == "" {
+		return peer.Keys
+	}
+	return []*PeerKey{{
+		Id:           DEFAULT_PEER_KEY_ID,
+		Label:        DEFAULT_PEER_KEY_ID,
+		PubKey:       peer.PubKey,
+		KeyAlgorithm: peer.KeyAlgorithm,
+	}}
+}
+
+func activePeerKey(peer *Peer, keyId string) (key *PeerKey, err error) {
+	for _, k := range peerKeys(peer) {
+		if !k.Revoked && (keyId == "" || k.Id == keyId) {
+			key = k
+			return
+		}
+	}
+	err = fmt.Errorf("no active key %s for peer %s", keyId, peer.Id)
+	return
+}
+
+func (manager *Manager) identify(token string, peerId string) (identity *Identity, err error) {
+	if identity, err = manager.AuthManager.Authenticate(token); err != nil {
 		return
 	}
-	if manager.AuthManager.AuthTokenPending[peerId] != string(token) {
-		err = fmt.Errorf("authentification failed wrong key")
-	} else {
-		manager.AuthManager.AuthTokenValid[string(token)] = peerId
+	if identity.PeerId != peerId {
+		identity, err = nil, fmt.Errorf("invalid access")
 	}
-	fmt.Println("done")
-	delete(manager.AuthManager.AuthTokenPending, peerId)
 	return
 }
 
-func (manager *Manager) GetSquadSByOwner(token string, owner string, lastIndex int64) (squad []*Squad, err error) {
-	if _, ok := manager.AuthManager.AuthTokenValid[token]; !ok {
-		err = fmt.Errorf("not a valid token provided")
+func (manager *Manager) authenticate(token string, peerId string) (err error) {
+	_, err = manager.identify(token, peerId)
+	return
+}
+
+func (manager *Manager) authenticateSquad(token string, peerId string, squadId string) (err error) {
+	identity, err := manager.identify(token, peerId)
+	if err != nil {
+		return
+	}
+	if !identity.HasSquadAccess(squadId) {
+		err = fmt.Errorf("this token does not grant access to squad %s", squadId)
+	}
+	return
+}
+
+func (manager *Manager) IssueAccessToken(token string, peerId string, squads []string) (claims *AccessClaims, accessToken string, err error) {
+	if manager.AuthManager.TokenSigner == nil {
+		err = fmt.Errorf("access tokens are not enabled on this server")
 		return
 	}
-	if manager.AuthManager.AuthTokenValid[token] != owner {
+	sessionPeerId, err := manager.AuthManager.ValidateSession(token)
+	if err != nil {
+		err = fmt.Errorf("access tokens can only be issued with a session token")
+		return
+	}
+	if sessionPeerId != peerId {
 		err = fmt.Errorf("invalid access")
 		return
 	}
-	squad, err = manager.SquadDBManager.GetSquadsByOwner(context.Background(), owner, 100, lastIndex)
+	accessToken, claims, err = manager.AuthManager.TokenSigner.Sign(peerId, squads)
+	return
+}
+
+func (manager *Manager) Logout(token string) (err error) {
+	if _, err = manager.AuthManager.ValidateSession(token); err != nil {
+		return
+	}
+	err = manager.AuthManager.RevokeSession(token)
+	return
+}
+
+func (manager *Manager) RevokePeerSessions(token string, peerId string) (err error) {
+	if err = manager.authenticate(token, peerId); err != nil {
+		return
+	}
+	err = manager.AuthManager.RevokePeerSessions(peerId)
+	return
+}
+
+func (manager *Manager) GetSquadSByOwner(token string, owner string, lastIndex int64) (squad []*Squad, err error) {
+	if err = manager.authenticate(token, owner); err != nil {
+		return
+	}
+	squad, err = manager.SquadStore.GetSquadsByOwner(context.Background(), owner, 100, lastIndex)
 	return
 }
 
 func (manager *Manager) CreateSquad(token string, id string, owner string, name string, squadType SquadType, password string, squadNetworkType SquadNetworkType, host string) (err error) {
+	_, err = manager.createSquad(token, id, owner, name, squadType, password, squadNetworkType, host, "")
+	return
+}
+
+func (manager *Manager) createSquad(token string, id string, owner string, name string, squadType SquadType, password string, squadNetworkType SquadNetworkType, host string, region string) (hostId string, err error) {
+	if err = manager.authenticateSquad(token, owner, id); err != nil {
+		return
+	}
+	store, err := manager.squadStoreFor(squadNetworkType)
+	if err != nil {
+		return
+	}
 	squadPass := ""
 	if squadType == PRIVATE {
-		if output, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost); err != nil {
-			return err
+		output, e := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if e != nil {
+			err = e
+			return
+		}
+		squadPass = string(output)
+	}
+	if squadNetworkType == HOSTED {
+		if host == "" {
+			if host, err = manager.ElectHost(region, id); err != nil {
+				return
+			}
 		} else {
-			squadPass = string(output)
+			manager.Hosts.reserve(host, id)
 		}
-	} else {
-		_ = password
+		defer func() {
+			if err != nil {
+				manager.Hosts.release(host, id)
+			}
+		}()
 	}
 	squad := Squad{
 		Owner:             owner,
@@ -167,131 +482,126 @@ func (manager *Manager) CreateSquad(token string, id string, owner string, name
 		Password:          squadPass,
 		Members:           make([]string, 0),
 		AuthorizedMembers: make([]string, 0),
+		Roles:             make(SquadRoles),
 		mutex:             new(sync.RWMutex),
 	}
+	if err = store.AddNewSquad(context.Background(), &squad); err != nil {
+		return
+	}
+	manager.Lock()
 	manager.Squads[id] = &squad
-	switch squadNetworkType {
-	case MESH:
-		err = manager.SquadDBManager.AddNewSquad(context.Background(), &squad)
-	case HOSTED:
-		err = manager.HostedSquadDBManager.AddNewHostedSquad(context.Background(), &squad)
+	manager.Unlock()
+	if squadNetworkType == HOSTED {
+		manager.notifySquad([]string{host}, owner, SQUAD_HOST_CHANGED, map[string]string{"squadId": id, "hostId": host})
 	}
-	if err != nil {
+	hostId = host
+	return
+}
+
+func (manager *Manager) findSquad(squadId string) (squad *Squad, store SquadStore, err error) {
+	for _, store = range []SquadStore{manager.SquadStore, manager.HostedSquadStore} {
+		if squad, err = store.GetSquad(context.Background(), squadId); err == nil && squad != nil {
+			squad.mutex = new(sync.RWMutex)
+			if squad.Roles == nil {
+				squad.Roles = make(SquadRoles)
+			}
+			return
+		}
+	}
+	squad, store, err = nil, nil, fmt.Errorf("this squad does not exist")
+	return
+}
+
+func (manager *Manager) authorizeSquad(token string, from string, squadId string, permission SquadPermission) (squad *Squad, store SquadStore, err error) {
+	if err = manager.authenticateSquad(token, from, squadId); err != nil {
 		return
 	}
+	if squad, store, err = manager.findSquad(squadId); err != nil {
+		return
+	}
+	if role := squad.Role(from); !role.Can(permission) {
+		squad, store, err = nil, nil, fmt.Errorf("you do not have the %s permission on squad %s", permission, squadId)
+	}
 	return
 }
 
 func (manager *Manager) DeleteSquad(token string, id string, from string) (err error) {
-	if _, ok := manager.Squads[id]; !ok {
-		err = fmt.Errorf("this squad does not exist")
+	squad, store, err := manager.authorizeSquad(token, from, id, SQUAD_DELETE)
+	if err != nil {
 		return
 	}
-	switch manager.Squads[id].NetworkType {
-	case MESH:
-		err = manager.SquadDBManager.DeleteSquad(context.Background(), id)
-	case HOSTED:
-		err = manager.HostedSquadDBManager.DeleteHostedSquad(context.Background(), id)
+	if err = store.DeleteSquad(context.Background(), id); err != nil {
+		return
 	}
+	manager.Hosts.release(squad.HostId, id)
+	manager.Mesh.remove(id)
+	manager.SignalGrants.revokeSquad(id)
+	manager.Lock()
 	delete(manager.Squads, id)
+	manager.Unlock()
 	return
 }
 
 func (manager *Manager) ModifySquad(token string, id string, from string, name string, squadType SquadType, password string) (err error) {
-	if _, ok := manager.Squads[id]; !ok {
-		err = fmt.Errorf("this squad does not exist")
+	if err = manager.authenticateSquad(token, from, id); err != nil {
 		return
 	}
-	if manager.Squads[id].Owner != from {
-		err = fmt.Errorf("you are not the owner of this squad so you can't modifiy it")
+	squad, _, err := manager.findSquad(id)
+	if err != nil {
 		return
 	}
-	manager.Squads[id].mutex.Lock()
-	defer manager.Squads[id].mutex.Unlock()
-	manager.Squads[id].Name = name
-	squadPass := ""
-	if squadType == PRIVATE {
-		output, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-		if err != nil {
-			return err
+	if name != "" && name != squad.Name {
+		if err = manager.UpdateSquadName(token, id, from, name); err != nil {
+			return
+		}
+	}
+	switch squadType {
+	case PRIVATE:
+		err = manager.UpdateSquadPassword(token, id, from, password)
+	case PUBLIC:
+		if squad.SquadType != PUBLIC {
+			err = manager.updateSquadSecurity(token, id, from, PUBLIC, "")
 		}
-		squadPass = string(output)
-		manager.Squads[id].Password = squadPass
-		manager.Squads[id].SquadType = PRIVATE
-	} else if squadType == PUBLIC {
-		manager.Squads[id].Password = squadPass
-		manager.Squads[id].SquadType = PRIVATE
 	}
 	return
 }
 
-func (manager *Manager) ConnectToSquad(token string, id string, from string, password string, networkType SquadNetworkType) (err error) {
-	var squad *Squad
-	if networkType == MESH {
-		if squad, err = manager.SquadDBManager.GetSquad(context.Background(), id); err != nil {
-			return
-		}
-	} else if networkType == HOSTED {
-		if squad, err = manager.HostedSquadDBManager.GetHostedSquad(context.Background(), id); err != nil {
-			return
-		}
+func (manager *Manager) ConnectToSquad(token string, id string, from string, password string, inviteCode string, networkType SquadNetworkType) (err error) {
+	if err = manager.authenticateSquad(token, from, id); err != nil {
+		return
+	}
+	squad, store, err := manager.findSquad(id)
+	if err != nil {
+		return
+	}
+	if squad.Role(from) == BANNED {
+		err = fmt.Errorf("access denied : you are banned from this squad")
+		return
 	}
-	fmt.Println(token)
-	fmt.Println(from)
-	fmt.Println(squad.AuthorizedMembers)
-	fmt.Println(manager.AuthManager.AuthTokenValid[token])
 	var contains bool = false
-	if _, ok := manager.AuthManager.AuthTokenValid[token]; ok {
-		if manager.AuthManager.AuthTokenValid[token] == from {
-			for _, am := range squad.AuthorizedMembers {
-				fmt.Println("authorized member", am)
-				if am == from {
-					contains = true
-				}
-			}
+	for _, am := range squad.AuthorizedMembers {
+		if am == from {
+			contains = true
+		}
+	}
+	if inviteCode != "" {
+		if err = manager.useSquadInvite(store, squad, from, inviteCode); err != nil {
+			return
 		}
+		contains = true
 	}
-	fmt.Println(contains)
-	var INCOMING string
+	var INCOMING SquadEvent
 	if squad.NetworkType == MESH {
-		INCOMING = string(INCOMING_MEMBER)
+		INCOMING = INCOMING_MEMBER
 	} else {
-		INCOMING = string(HOSTED_INCOMING_MEMBER)
+		INCOMING = HOSTED_INCOMING_MEMBER
 	}
 	squad.mutex = &sync.RWMutex{}
 	if squad.SquadType == PUBLIC || contains {
 		squad.Join(from)
-		for _, member := range squad.Members {
-			if member != from {
-				if _, ok := manager.GRPCPeers[member]; ok {
-					if err := manager.GRPCPeers[member].Conn.Send(&Response{
-						Type:    INCOMING,
-						Success: true,
-						Payload: map[string]string{
-							"id": from,
-						},
-					}); err != nil {
-						delete(manager.GRPCPeers, member)
-						return err
-					}
-				} else if _, ok := manager.WSPeers[member]; ok {
-					if err = manager.WSPeers[member].Conn.WriteJSON(map[string]interface{}{
-						"from":    from,
-						"to":      member,
-						"type":    INCOMING,
-						"payload": map[string]string{},
-					}); err != nil {
-						log.Println(err)
-						return
-					}
-				}
-			}
-		}
-		switch networkType {
-		case MESH:
-			err = manager.SquadDBManager.UpdateSquadMembers(context.Background(), squad.ID, squad.Members)
-		case HOSTED:
-			err = manager.HostedSquadDBManager.UpdateHostedSquadMembers(context.Background(), squad.ID, squad.Members)
+		manager.notifySquad(squad.Members, from, INCOMING, map[string]string{"id": from})
+		if err = store.UpdateSquadMembers(context.Background(), squad.ID, squad.Members); err == nil {
+			manager.meshJoined(squad, from)
 		}
 		return
 	}
@@ -301,37 +611,9 @@ func (manager *Manager) ConnectToSquad(token string, id string, from string, pas
 			return
 		}
 		squad.Join(from)
-		for _, member := range squad.Members {
-			if member != from {
-				if _, ok := manager.GRPCPeers[member]; ok {
-					if err := manager.GRPCPeers[member].Conn.Send(&Response{
-						Type:    INCOMING,
-						Success: true,
-						Payload: map[string]string{
-							"id": from,
-						},
-					}); err != nil {
-						delete(manager.GRPCPeers, member)
-						return err
-					}
-				} else if _, ok := manager.WSPeers[member]; ok {
-					if err = manager.WSPeers[member].Conn.WriteJSON(map[string]interface{}{
-						"from":    from,
-						"to":      member,
-						"type":    INCOMING,
-						"payload": map[string]string{},
-					}); err != nil {
-						log.Println(err)
-						return
-					}
-				}
-			}
-		}
-		switch networkType {
-		case MESH:
-			err = manager.SquadDBManager.UpdateSquadMembers(context.Background(), squad.ID, squad.Members)
-		case HOSTED:
-			err = manager.HostedSquadDBManager.UpdateHostedSquadMembers(context.Background(), squad.ID, squad.Members)
+		manager.notifySquad(squad.Members, from, INCOMING, map[string]string{"id": from})
+		if err = store.UpdateSquadMembers(context.Background(), squad.ID, squad.Members); err == nil {
+			manager.meshJoined(squad, from)
 		}
 		return
 	}
@@ -339,221 +621,380 @@ func (manager *Manager) ConnectToSquad(token string, id string, from string, pas
 	return
 }
 
-func (manager *Manager) LeaveSquad(id string, from string, networkType SquadNetworkType) (err error) {
-	var squad *Squad
-	if networkType == MESH {
-		if squad, err = manager.SquadDBManager.GetSquad(context.Background(), id); err != nil {
-			return
-		}
-	} else if networkType == HOSTED {
-		if squad, err = manager.HostedSquadDBManager.GetHostedSquad(context.Background(), id); err != nil {
-			return
-		}
+func (manager *Manager) LeaveSquad(token string, id string, from string, networkType SquadNetworkType) (err error) {
+	if err = manager.authenticateSquad(token, from, id); err != nil {
+		return
 	}
-	squad.mutex = &sync.RWMutex{}
-	var memberIndex int
-	for i, member := range squad.Members {
-		if member == from {
-			memberIndex = i
-			break
-		}
+	err = manager.leaveSquad(id, from)
+	return
+}
+
+func (manager *Manager) leaveSquad(id string, from string) (err error) {
+	squad, store, err := manager.findSquad(id)
+	if err != nil {
+		return
 	}
-	squad.mutex.Lock()
-	fmt.Println(squad.Members)
-	if len(squad.Members) < 2 {
-		newMembers := []string{}
-		squad.Members = newMembers
-	} else {
-		squad.Members[len(squad.Members)-1], squad.Members[memberIndex] = squad.Members[memberIndex], squad.Members[len(squad.Members)-1]
-		newMembers := squad.Members[:len(squad.Members)-1]
-		squad.Members = newMembers
+	if !containsPeer(squad.Members, from) {
+		err = fmt.Errorf("%s is not a member of squad %s", from, id)
+		return
 	}
+	squad.mutex = &sync.RWMutex{}
+	squad.mutex.Lock()
+	squad.Members = withoutPeer(squad.Members, from)
 	squad.mutex.Unlock()
-	manager.RLock()
-	var LEAVING string
+	var LEAVING SquadEvent
 	if squad.NetworkType == MESH {
-		LEAVING = string(LEAVING_MEMBER)
+		LEAVING = LEAVING_MEMBER
 	} else {
-		LEAVING = string(HOSTED_LEAVING_MEMBER)
+		LEAVING = HOSTED_LEAVING_MEMBER
 	}
-	defer manager.RUnlock()
-	for _, member := range squad.Members {
-		if member != from {
-			if _, ok := manager.GRPCPeers[member]; ok {
-				if err := manager.GRPCPeers[member].Conn.Send(&Response{
-					Type:    LEAVING,
-					Success: true,
-					Payload: map[string]string{
-						"id": from,
-					},
-				}); err != nil {
-					delete(manager.GRPCPeers, member)
-					return err
-				}
-			} else if _, ok := manager.WSPeers[member]; ok {
-				if err = manager.WSPeers[member].Conn.WriteJSON(map[string]interface{}{
-					"from":    from,
-					"to":      member,
-					"type":    LEAVING,
-					"payload": map[string]string{},
-				}); err != nil {
-					log.Println(err)
-					return
-				}
-			}
-		}
-	}
-	fmt.Println(squad.Members)
-	switch networkType {
-	case MESH:
-		err = manager.SquadDBManager.UpdateSquadMembers(context.Background(), squad.ID, squad.Members)
-	case HOSTED:
-		err = manager.HostedSquadDBManager.UpdateHostedSquadMembers(context.Background(), squad.ID, squad.Members)
+	manager.notifySquad(squad.Members, from, LEAVING, map[string]string{"id": from})
+	manager.Mesh.leave(squad.ID, from)
+	if err = store.UpdateSquadMembers(context.Background(), squad.ID, squad.Members); err == nil {
+		manager.contactsChanged(from)
+		manager.migrateSquad(squad)
 	}
 	return
 }
 
 func (manager *Manager) ListAllSquads(lastIndex int64, networkType SquadNetworkType) (squads []*Squad, err error) {
-	switch networkType {
-	case MESH:
-		squads, err = manager.SquadDBManager.GetSquads(context.Background(), 100, lastIndex)
-	case HOSTED:
-		squads, err = manager.HostedSquadDBManager.GetHostedSquads(context.Background(), 100, lastIndex)
+	store, err := manager.squadStoreFor(networkType)
+	if err != nil {
+		return
 	}
+	squads, err = store.GetSquads(context.Background(), 100, lastIndex)
 	return
 }
 
 func (manager *Manager) ListSquadsByName(lastIndex int64, squadName string, networkType SquadNetworkType) (squads []*Squad, err error) {
-	switch networkType {
-	case MESH:
-		squads, err = manager.SquadDBManager.GetSquadsByName(context.Background(), squadName, 100, lastIndex)
-	case HOSTED:
-		squads, err = manager.HostedSquadDBManager.GetHostedSquadsByName(context.Background(), squadName, 100, lastIndex)
+	store, err := manager.squadStoreFor(networkType)
+	if err != nil {
+		return
 	}
+	squads, err = store.GetSquadsByName(context.Background(), squadName, 100, lastIndex)
 	return
 }
 
 func (manager *Manager) ListSquadsByID(lastIndex int64, squadId string, networkType SquadNetworkType) (squads []*Squad, err error) {
-	switch networkType {
-	case MESH:
-		squads, err = manager.SquadDBManager.GetSquadsByID(context.Background(), squadId, 100, lastIndex)
-	case HOSTED:
-		squads, err = manager.HostedSquadDBManager.GetHostedSquadsByID(context.Background(), squadId, 100, lastIndex)
+	store, err := manager.squadStoreFor(networkType)
+	if err != nil {
+		return
 	}
+	squads, err = store.GetSquadsByID(context.Background(), squadId, 100, lastIndex)
 	return
 }
 
 func (manager *Manager) ListAllPeers(lastIndex int64) (peers []*Peer, err error) {
 
-	peers, err = manager.PeerDBManager.GetPeers(context.Background(), 100, lastIndex)
+	peers, err = manager.PeerStore.GetPeers(context.Background(), 100, lastIndex)
 	return
 }
 
 func (manager *Manager) ListPeersByID(lastIndex int64, id string) (peers []*Peer, err error) {
 
-	peers, err = manager.PeerDBManager.GetPeersByID(context.Background(), id, 100, lastIndex)
+	peers, err = manager.PeerStore.GetPeersByID(context.Background(), id, 100, lastIndex)
 	return
 }
 
 func (manager *Manager) ListPeersByName(lastIndex int64, name string) (peers []*Peer, err error) {
 
-	peers, err = manager.PeerDBManager.GetPeersByName(context.Background(), name, 100, lastIndex)
+	peers, err = manager.PeerStore.GetPeersByName(context.Background(), name, 100, lastIndex)
 	return
 }
 
-func (manager *Manager) UpdateSquadName(squadId string, squadName string) (err error) {
-	err = manager.SquadDBManager.UpdateSquadName(context.Background(), squadId, squadName)
+func (manager *Manager) UpdateSquadName(token string, squadId string, from string, squadName string) (err error) {
+	_, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_RENAME)
+	if err != nil {
+		return
+	}
+	if err = store.UpdateSquadName(context.Background(), squadId, squadName); err != nil {
+		return
+	}
+	manager.Lock()
+	if squad, ok := manager.Squads[squadId]; ok {
+		squad.Name = squadName
+	}
+	manager.Unlock()
 	return
 }
 
-func (manager *Manager) UpdateSquadAuthorizedMembers(squadId string, authorizedMembers string) (err error) {
-	var squad *Squad
-	if squad, err = manager.SquadDBManager.GetSquad(context.Background(), squadId); err != nil {
+func (manager *Manager) UpdateSquadAuthorizedMembers(token string, squadId string, from string, authorizedMember string) (err error) {
+	squad, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_INVITE)
+	if err != nil {
+		return
+	}
+	if squad.Role(authorizedMember) == BANNED {
+		err = fmt.Errorf("peer %s is banned from squad %s", authorizedMember, squadId)
 		return
 	}
 	for _, v := range squad.AuthorizedMembers {
-		if v == authorizedMembers {
+		if v == authorizedMember {
 			err = fmt.Errorf("user already authorized")
 			return
 		}
 	}
-	err = manager.SquadDBManager.UpdateSquadAuthorizedMembers(context.Background(), squadId, append(squad.AuthorizedMembers, authorizedMembers))
+	err = store.UpdateSquadAuthorizedMembers(context.Background(), squadId, append(squad.AuthorizedMembers, authorizedMember))
 	return
 }
 
-func (manager *Manager) UpdateSquadPassword(squadId string, password string) (err error) {
+func (manager *Manager) UpdateSquadPassword(token string, squadId string, from string, password string) (err error) {
 	pass, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return
 	}
-	err = manager.SquadDBManager.UpdateSquadName(context.Background(), squadId, string(pass))
+	err = manager.updateSquadSecurity(token, squadId, from, PRIVATE, string(pass))
 	return
 }
 
-func (manager *Manager) AddGrpcPeer(peer GrpcManager_LinkServer, id string, req *Request) (err error) {
-	fmt.Printf("adding peer %s\n", req.From)
+func (manager *Manager) updateSquadSecurity(token string, squadId string, from string, squadType SquadType, hashedPassword string) (err error) {
+	_, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_CHANGE_PASSWORD)
+	if err != nil {
+		return
+	}
+	if err = store.UpdateSquadPassword(context.Background(), squadId, hashedPassword); err != nil {
+		return
+	}
+	if err = store.UpdateSquadType(context.Background(), squadId, squadType); err != nil {
+		return
+	}
 	manager.Lock()
-	manager.GRPCPeers[req.From] = &GRPCPeer{Conn: peer, State: CONNECTED}
+	if squad, ok := manager.Squads[squadId]; ok {
+		squad.Password, squad.SquadType = hashedPassword, squadType
+	}
 	manager.Unlock()
-	if _, ok := req.Payload["to"]; ok {
-		if _, ok := manager.GRPCPeers[req.From]; ok {
-			if err = manager.GRPCPeers[req.From].Conn.Send(&Response{
-				Type:    req.Type,
-				Success: true,
-				Payload: req.Payload,
-			}); err != nil {
-				return
-			}
+	return
+}
+
+func (manager *Manager) KickFromSquad(token string, squadId string, from string, target string) (err error) {
+	squad, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_KICK)
+	if err != nil {
+		return
+	}
+	if role := squad.Role(target); role == "" || role == BANNED {
+		err = fmt.Errorf("peer %s is not a member of squad %s", target, squadId)
+		return
+	}
+	if !squad.Role(from).Outranks(squad.Role(target)) {
+		err = fmt.Errorf("you cannot kick %s from squad %s", target, squadId)
+		return
+	}
+	recipients := squad.Members
+	delete(squad.Roles, target)
+	if err = manager.removeSquadMember(store, squad, target); err != nil {
+		return
+	}
+	manager.notifySquad(recipients, from, KICKED_MEMBER, map[string]string{"squadId": squadId, "id": target})
+	return
+}
+
+func (manager *Manager) BanFromSquad(token string, squadId string, from string, target string) (err error) {
+	squad, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_BAN)
+	if err != nil {
+		return
+	}
+	if !squad.Role(from).Outranks(squad.Role(target)) {
+		err = fmt.Errorf("you cannot ban %s from squad %s", target, squadId)
+		return
+	}
+	recipients := squad.Members
+	squad.Roles[target] = BANNED
+	if err = manager.removeSquadMember(store, squad, target); err != nil {
+		return
+	}
+	manager.notifySquad(recipients, from, BANNED_MEMBER, map[string]string{"squadId": squadId, "id": target})
+	return
+}
+
+func (manager *Manager) UnbanFromSquad(token string, squadId string, from string, target string) (err error) {
+	squad, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_BAN)
+	if err != nil {
+		return
+	}
+	if squad.Role(target) != BANNED {
+		err = fmt.Errorf("peer %s is not banned from squad %s", target, squadId)
+		return
+	}
+	delete(squad.Roles, target)
+	err = store.UpdateSquadRoles(context.Background(), squadId, squad.Roles)
+	return
+}
+
+func (manager *Manager) SetSquadRole(token string, squadId string, from string, target string, role SquadRole) (err error) {
+	squad, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_MANAGE_ROLES)
+	if err != nil {
+		return
+	}
+	if role != ADMIN && role != MODERATOR && role != MEMBER {
+		err = fmt.Errorf("the role %s cannot be granted", role)
+		return
+	}
+	current := squad.Role(target)
+	if current == "" || current == BANNED {
+		err = fmt.Errorf("peer %s is not a member of squad %s", target, squadId)
+		return
+	}
+	if actor := squad.Role(from); !actor.Outranks(current) || !actor.Outranks(role) {
+		err = fmt.Errorf("you cannot make %s %s of squad %s", target, role, squadId)
+		return
+	}
+	if role == MEMBER {
+		delete(squad.Roles, target)
+	} else {
+		squad.Roles[target] = role
+	}
+	if err = store.UpdateSquadRoles(context.Background(), squadId, squad.Roles); err != nil {
+		return
+	}
+	manager.notifySquad(append(squad.Members, target), from, SQUAD_ROLE_CHANGED, map[string]string{"squadId": squadId, "id": target, "role": string(role)})
+	return
+}
+
+func (manager *Manager) TransferSquadOwnership(token string, squadId string, from string, newOwner string) (err error) {
+	squad, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_TRANSFER_OWNERSHIP)
+	if err != nil {
+		return
+	}
+	if role := squad.Role(newOwner); role == "" || role == BANNED || role == OWNER {
+		err = fmt.Errorf("peer %s cannot become the owner of squad %s", newOwner, squadId)
+		return
+	}
+	delete(squad.Roles, newOwner)
+	squad.Roles[from] = ADMIN
+	if err = store.UpdateSquadRoles(context.Background(), squadId, squad.Roles); err != nil {
+		return
+	}
+	if err = store.UpdateSquadOwner(context.Background(), squadId, newOwner); err != nil {
+		return
+	}
+	manager.Lock()
+	if s, ok := manager.Squads[squadId]; ok {
+		s.Owner = newOwner
+	}
+	manager.Unlock()
+	manager.notifySquad(append(squad.Members, newOwner), from, SQUAD_OWNER_CHANGED, map[string]string{"squadId": squadId, "id": newOwner})
+	return
+}
+
+func (manager *Manager) removeSquadMember(store SquadStore, squad *Squad, peerId string) (err error) {
+	members, authorizedMembers := make([]string, 0, len(squad.Members)), make([]string, 0, len(squad.AuthorizedMembers))
+	for _, member := range squad.Members {
+		if member != peerId {
+			members = append(members, member)
 		}
 	}
-	err = manager.manage(peer)
-	delete(manager.GRPCPeers, req.From)
+	for _, member := range squad.AuthorizedMembers {
+		if member != peerId {
+			authorizedMembers = append(authorizedMembers, member)
+		}
+	}
+	if err = store.UpdateSquadMembers(context.Background(), squad.ID, members); err != nil {
+		return
+	}
+	manager.Mesh.leave(squad.ID, peerId)
+	if err = store.UpdateSquadAuthorizedMembers(context.Background(), squad.ID, authorizedMembers); err != nil {
+		return
+	}
+	if err = store.UpdateSquadRoles(context.Background(), squad.ID, squad.Roles); err != nil {
+		return
+	}
+	squad.Members, squad.AuthorizedMembers = members, authorizedMembers
+	manager.contactsChanged(peerId)
+	manager.migrateSquad(squad)
+	return
+}
+
+func (manager *Manager) notifySquad(recipients []string, from string, event SquadEvent, payload map[string]string) {
+	for _, member := range recipients {
+		if member == from || (!offlineMessageTypes[string(event)] && !manager.reachable(member)) {
+			continue
+		}
+		if err := manager.deliver(&Envelope{
+			Type:    string(event),
+			From:    from,
+			To:      member,
+			Payload: payload,
+		}); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+func (manager *Manager) RemoveWSPeer(peerId string, peer *WSPeer) {
+	if manager.Router.removeWSPeer(peerId, peer) {
+		manager.peerLost(peerId)
+	}
+}
+
+func (manager *Manager) RemoveGrpcPeer(peerId string, peer *GRPCPeer) {
+	if manager.Router.removeGRPCPeer(peerId, peer) {
+		manager.peerLost(peerId)
+	}
+}
+
+func (manager *Manager) AddGrpcPeer(stream GrpcManager_LinkServer, id string, req *Request) (err error) {
+	fmt.Printf("adding peer %s\n", id)
+	peer := manager.Router.NewGRPCPeer(stream)
+	if previous := manager.Router.addGRPCPeer(id, peer); previous != nil {
+		previous.Close()
+	}
+	defer manager.RemoveGrpcPeer(id, peer)
+	manager.openSession(id, req.Payload)
+	if to, ok := req.Payload["to"]; ok {
+		if err = peer.Send(&Envelope{
+			Type:    req.Type,
+			From:    id,
+			To:      to,
+			Payload: req.Payload,
+		}); err != nil {
+			return
+		}
+	}
+	err = manager.manage(peer, id)
 	return
 }
 
-func (manager *Manager) manage(peer GrpcManager_LinkServer) (err error) {
-	done, errch := make(chan struct{}), make(chan error)
+func (manager *Manager) manage(peer *GRPCPeer, id string) (err error) {
+	errch := make(chan error, 1)
 	go func() {
 		for {
-			req, err := peer.Recv()
+			req, err := peer.Conn.Recv()
 			if err != nil {
 				errch <- err
 				return
 			}
+			peer.touch()
+			req.From = id
 			fmt.Println(req)
-			if _, ok := req.Payload["to"]; ok {
-				to := req.Payload["to"]
-				if _, ok := manager.GRPCPeers[to]; ok {
-					if err := manager.GRPCPeers[to].Conn.Send(&Response{
-						Type:    req.Type,
-						Success: true,
-						Payload: req.Payload,
-					}); err != nil {
-						errch <- err
-						return
-					}
-				} else if _, ok := manager.WSPeers[to]; ok {
-					if err = manager.WSPeers[to].Conn.WriteJSON(map[string]interface{}{
-						"from":    req.From,
-						"to":      to,
-						"type":    req.Type,
-						"payload": req.Payload,
-					}); err != nil {
-						log.Println(err)
-						return
-					}
-				}
+			if req.Type == HEARTBEAT {
+				continue
+			}
+			if req.Type == MESSAGE_ACK {
+				manager.ack(id, req.Payload)
+				continue
+			}
+			if req.Type == MESH_PAIR_STATE {
+				manager.meshPairState(id, req.Payload)
+				continue
+			}
+			if to, ok := req.Payload["to"]; ok || signalingTypes[req.Type] {
+				manager.relay(&Envelope{
+					Type:    req.Type,
+					From:    req.From,
+					To:      to,
+					Payload: req.Payload,
+					Signal:  req.Signal,
+				})
 			}
 		}
 	}()
 	select {
-	case <-done:
+	case <-peer.outbox.done:
 		log.Println("manage is done")
 		return
 	case err = <-errch:
 		return
-	case <-peer.Context().Done():
-		err = peer.Context().Err()
+	case <-peer.Conn.Context().Done():
+		err = peer.Conn.Context().Err()
 		return
 	}
 }
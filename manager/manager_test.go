@@ -1,72 +1,142 @@
 package manager
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"testing"
 )
 
-func TestCreateHostedSquad(t *testing.T) {
-	m, err := NewManager()
+func newTestSession(t *testing.T, m *Manager, peerId string) string {
+	session, err := m.AuthManager.IssueSession(peerId, "", peerId+"_token")
 	if err != nil {
+		t.Fatal(err)
+	}
+	return session.Token
+}
+
+func TestCreateHostedSquad(t *testing.T) {
+	m := NewMemoryManager()
+	if err := m.CreateSquad(newTestSession(t, m, "lolo"), "0xff", "lolo", "test squad", PRIVATE, "lolo2001", HOSTED, "lolo"); err != nil {
 		t.Error(err)
 		return
 	}
-	if err = m.CreateSquad("", "0xff", "lolo", "test squad", PRIVATE, "lolo2001", HOSTED, "lolo"); err != nil {
+	if _, err := m.HostedSquadStore.GetSquad(context.Background(), "0xff"); err != nil {
 		t.Error(err)
 	}
+	if _, err := m.SquadStore.GetSquad(context.Background(), "0xff"); err == nil {
+		t.Error("hosted squad stored in the mesh store")
+	}
 }
 
 func TestCreateSquad(t *testing.T) {
-	m, err := NewManager()
-	if err != nil {
+	m := NewMemoryManager()
+	if err := m.CreateSquad("", "0xfg", "lolo", "test squad", PRIVATE, "lolo2001", MESH, "lolo"); err == nil {
+		t.Error("expected an error without a session token")
+	}
+	if err := m.CreateSquad(newTestSession(t, m, "lolo2"), "0xfg", "lolo", "test squad", PRIVATE, "lolo2001", MESH, "lolo"); err == nil {
+		t.Error("expected an error with the session of another peer")
+	}
+	if err := m.CreateSquad(newTestSession(t, m, "lolo"), "0xfg", "lolo", "test squad", PRIVATE, "lolo2001", MESH, "lolo"); err != nil {
 		t.Error(err)
 		return
 	}
-	if err = m.CreateSquad("", "0xfg", "lolo", "test squad", PRIVATE, "lolo2001", MESH, "lolo"); err != nil {
-		t.Error(err)
+	if err := m.CreateSquad(newTestSession(t, m, "lolo"), "0xfg", "lolo", "test squad", PRIVATE, "lolo2001", MESH, "lolo"); err == nil {
+		t.Error("expected an error for a duplicated squad id")
 	}
 }
 
 func TestCreatePeer(t *testing.T) {
-	m, err := NewManager()
+	m := NewMemoryManager()
+	if err := m.CreatePeer("lolo", PUB_KEY, "lolo"); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := m.CreatePeer("lolo", PUB_KEY, "lolo"); err == nil {
+		t.Error("expected an error for a duplicated peer id")
+	}
+	peers, err := m.ListPeersByName(0, "lo")
 	if err != nil {
 		t.Error(err)
 		return
 	}
-	_ = m
-	// if err = m.P("0xff", "lolo", "test squad", PRIVATE, "lolo2001", HOSTED, "lolo"); err != nil {
-	// 	t.Error(err)
-	// }
+	if len(peers) != 1 || peers[0].Id != "lolo" {
+		t.Errorf("unexpected peers %v", peers)
+	}
 }
 
 func TestConnectSquad(t *testing.T) {
-	m, err := NewManager()
-	if err != nil {
+	m := NewMemoryManager()
+	if err := m.CreateSquad(newTestSession(t, m, "lolo"), "0xff", "lolo", "test squad", PRIVATE, "lolo2001", HOSTED, "lolo"); err != nil {
 		t.Error(err)
 		return
 	}
-	if err = m.ConnectToSquad("", "0xff", "lolo3", "lolo2001", HOSTED); err != nil {
+	if err := m.ConnectToSquad(newTestSession(t, m, "lolo3"), "0xff", "lolo3", "wrong", "", HOSTED); err == nil {
+		t.Error("expected access denied with a wrong password")
+		return
+	}
+	if err := m.ConnectToSquad(newTestSession(t, m, "lolo3"), "0xff", "lolo3", "lolo2001", "", HOSTED); err != nil {
+		t.Error(err)
+		return
+	}
+	squad, err := m.HostedSquadStore.GetSquad(context.Background(), "0xff")
+	if err != nil {
 		t.Error(err)
 		return
 	}
+	if len(squad.Members) != 1 || squad.Members[0] != "lolo3" {
+		t.Errorf("unexpected members %v", squad.Members)
+	}
 }
 
 func TestLeaveSquad(t *testing.T) {
-	m, err := NewManager()
-	if err != nil {
+	m := NewMemoryManager()
+	if err := m.CreateSquad(newTestSession(t, m, "lolo"), "0xff", "lolo", "test squad", PUBLIC, "", HOSTED, "lolo"); err != nil {
+		t.Error(err)
+		return
+	}
+	tokens := map[string]string{}
+	for _, member := range []string{"lolo", "lolo2", "lolo3", "lolo4"} {
+		tokens[member] = newTestSession(t, m, member)
+	}
+	for _, member := range []string{"lolo", "lolo2", "lolo3"} {
+		if err := m.ConnectToSquad(tokens[member], "0xff", member, "", "", HOSTED); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	if err := m.LeaveSquad(tokens["lolo2"], "0xff", "lolo", HOSTED); err == nil {
+		t.Error("expected a peer to be unable to remove another member")
+	}
+	if err := m.LeaveSquad(tokens["lolo4"], "0xff", "lolo4", HOSTED); err == nil {
+		t.Error("expected a peer outside the squad to be unable to leave it")
+	}
+	if err := m.LeaveSquad(tokens["lolo"], "0xff", "lolo", HOSTED); err != nil {
 		t.Error(err)
 		return
 	}
-	if err = m.LeaveSquad("0xff", "lolo", HOSTED); err != nil {
+	squad, err := m.HostedSquadStore.GetSquad(context.Background(), "0xff")
+	if err != nil {
 		t.Error(err)
 		return
 	}
+	if len(squad.Members) != 2 {
+		t.Errorf("unexpected members %v", squad.Members)
+	}
+	for _, member := range squad.Members {
+		if member == "lolo" {
+			t.Errorf("lolo is still a member of %v", squad.Members)
+		}
+	}
 }
 
 func TestListAllSquads(t *testing.T) {
-	m, err := NewManager()
-	if err != nil {
+	m := NewMemoryManager()
+	if err := m.CreateSquad(newTestSession(t, m, "lolo"), "0xfg", "lolo", "test squad", PUBLIC, "", MESH, ""); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := m.CreateSquad(newTestSession(t, m, "lolo"), "0xff", "lolo", "test squad", PUBLIC, "", HOSTED, "lolo"); err != nil {
 		t.Error(err)
 		return
 	}
@@ -74,17 +144,32 @@ func TestListAllSquads(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	t.Error(squads)
+	if len(squads) != 1 || squads[0].ID != "0xfg" {
+		t.Errorf("unexpected mesh squads %v", squads)
+	}
 	squads, err = m.ListAllSquads(0, HOSTED)
 	if err != nil {
 		t.Error(err)
 	}
-	t.Error(squads)
+	if len(squads) != 1 || squads[0].ID != "0xff" {
+		t.Errorf("unexpected hosted squads %v", squads)
+	}
+	squads, err = m.ListAllSquads(1, MESH)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(squads) != 0 {
+		t.Errorf("expected no squads after the last index, got %v", squads)
+	}
 }
 
 func TestListSquadsByID(t *testing.T) {
-	m, err := NewManager()
-	if err != nil {
+	m := NewMemoryManager()
+	if err := m.CreateSquad(newTestSession(t, m, "lolo"), "0xfg", "lolo", "test squad", PUBLIC, "", MESH, ""); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := m.CreateSquad(newTestSession(t, m, "lolo"), "0xff", "lolo", "test squad", PUBLIC, "", HOSTED, "lolo"); err != nil {
 		t.Error(err)
 		return
 	}
@@ -92,17 +177,25 @@ func TestListSquadsByID(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	t.Error(squads)
+	if len(squads) != 1 {
+		t.Errorf("unexpected mesh squads %v", squads)
+	}
 	squads, err = m.ListSquadsByID(0, "xf", HOSTED)
 	if err != nil {
 		t.Error(err)
 	}
-	t.Error(squads)
+	if len(squads) != 1 {
+		t.Errorf("unexpected hosted squads %v", squads)
+	}
 }
 
 func TestListSquadsByName(t *testing.T) {
-	m, err := NewManager()
-	if err != nil {
+	m := NewMemoryManager()
+	if err := m.CreateSquad(newTestSession(t, m, "lolo"), "0xfg", "lolo", "test squad", PUBLIC, "", MESH, ""); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := m.CreateSquad(newTestSession(t, m, "lolo"), "0xff", "lolo", "other", PUBLIC, "", HOSTED, "lolo"); err != nil {
 		t.Error(err)
 		return
 	}
@@ -110,25 +203,32 @@ func TestListSquadsByName(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	t.Error(squads)
+	if len(squads) != 1 {
+		t.Errorf("unexpected mesh squads %v", squads)
+	}
 	squads, err = m.ListSquadsByName(0, "squad", HOSTED)
 	if err != nil {
 		t.Error(err)
 	}
-	t.Error(squads)
+	if len(squads) != 0 {
+		t.Errorf("unexpected hosted squads %v", squads)
+	}
 }
 
 func TestPeerCreate(t *testing.T) {
-	m, err := NewManager()
-	if err != nil {
+	m := NewMemoryManager()
+	if err := m.CreatePeer("lolo_test_2", PUB_KEY, "lolo"); err != nil {
 		t.Error(err)
 		return
 	}
-	ec, err := m.PeerAuthInit("lolo_test_2")
+	ec, authType, err := m.PeerAuthInit("lolo_test_2", "")
 	if err != nil {
 		t.Error(err)
 		return
 	}
+	if authType != RSA {
+		t.Errorf("expected the PKCS#1 key to use the %s flow, got %s", RSA, authType)
+	}
 	privKey, err := m.AuthManager.parsePrivKey(PRIV_KEY)
 	if err != nil {
 		t.Error(err)
@@ -139,8 +239,15 @@ func TestPeerCreate(t *testing.T) {
 		t.Error(err)
 		return
 	}
-	if err = m.PeerAuthVerif("lolo_test_2", res); err != nil {
+	session, _, err := m.PeerAuthVerif("lolo_test_2", "", res)
+	if err != nil {
 		t.Error(err)
 		return
 	}
+	if err = m.authenticate(session.Token, "lolo_test_2"); err != nil {
+		t.Error(err)
+	}
+	if _, _, err = m.PeerAuthVerif("lolo_test_2", "", res); err == nil {
+		t.Error("expected the challenge to be consumed")
+	}
 }
@@ -0,0 +1,694 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type MemorySquadStore struct {
+	squads       map[string]*Squad
+	order        []string
+	invites      map[string]*SquadInvite
+	joinRequests []*SquadJoinRequest
+	*sync.RWMutex
+}
+
+type MemoryPeerStore struct {
+	peers          map[string]*Peer
+	order          []string
+	friendRequests []*FriendRequest
+	*sync.RWMutex
+}
+
+type MemorySessionStore struct {
+	sessions map[string]*Session
+	*sync.RWMutex
+}
+
+type MemoryMessageStore struct {
+	messages map[string][]*OfflineMessage
+	*sync.RWMutex
+}
+
+func NewMemorySquadStore() (memorySquadStore *MemorySquadStore) {
+	memorySquadStore = &MemorySquadStore{
+		squads:       make(map[string]*Squad),
+		order:        make([]string, 0),
+		invites:      make(map[string]*SquadInvite),
+		joinRequests: make([]*SquadJoinRequest, 0),
+		RWMutex:      &sync.RWMutex{},
+	}
+	return
+}
+
+func NewMemoryPeerStore() (memoryPeerStore *MemoryPeerStore) {
+	memoryPeerStore = &MemoryPeerStore{
+		peers:          make(map[string]*Peer),
+		order:          make([]string, 0),
+		friendRequests: make([]*FriendRequest, 0),
+		RWMutex:        &sync.RWMutex{},
+	}
+	return
+}
+
+func NewMemorySessionStore() (memorySessionStore *MemorySessionStore) {
+	memorySessionStore = &MemorySessionStore{
+		sessions: make(map[string]*Session),
+		RWMutex:  &sync.RWMutex{},
+	}
+	return
+}
+
+func NewMemoryMessageStore() (memoryMessageStore *MemoryMessageStore) {
+	memoryMessageStore = &MemoryMessageStore{
+		messages: make(map[string][]*OfflineMessage),
+		RWMutex:  &sync.RWMutex{},
+	}
+	return
+}
+
+func copySquad(squad *Squad) *Squad {
+	s := *squad
+	s.Members = append([]string{}, squad.Members...)
+	s.AuthorizedMembers = append([]string{}, squad.AuthorizedMembers...)
+	s.Roles = copyRoles(squad.Roles)
+	s.mutex = new(sync.RWMutex)
+	return &s
+}
+
+func copyRoles(roles SquadRoles) SquadRoles {
+	c := make(SquadRoles, len(roles))
+	for peerId, role := range roles {
+		c[peerId] = role
+	}
+	return c
+}
+
+func paginate(length int, limit int64, lastIndex int64) (start int, end int) {
+	start, end = int(lastIndex), length
+	if start > length {
+		start = length
+	}
+	if limit > 0 && start+int(limit) < end {
+		end = start + int(limit)
+	}
+	return
+}
+
+func (mss *MemorySquadStore) filter(limit int64, lastIndex int64, match func(*Squad) bool) (squads []*Squad) {
+	mss.RLock()
+	defer mss.RUnlock()
+	matching := make([]*Squad, 0)
+	for _, id := range mss.order {
+		if squad := mss.squads[id]; match(squad) {
+			matching = append(matching, squad)
+		}
+	}
+	start, end := paginate(len(matching), limit, lastIndex)
+	squads = make([]*Squad, 0, end-start)
+	for _, squad := range matching[start:end] {
+		squads = append(squads, copySquad(squad))
+	}
+	return
+}
+
+func (mss *MemorySquadStore) update(squadId string, apply func(*Squad)) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	if squad, ok := mss.squads[squadId]; ok {
+		apply(squad)
+	}
+	return
+}
+
+func (mss *MemorySquadStore) AddNewSquad(ctx context.Context, squad *Squad) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	if _, ok := mss.squads[squad.ID]; ok {
+		err = fmt.Errorf("A squad with id %s already exist", squad.ID)
+		return
+	}
+	mss.squads[squad.ID] = copySquad(squad)
+	mss.order = append(mss.order, squad.ID)
+	return
+}
+
+func (mss *MemorySquadStore) GetSquad(ctx context.Context, squadId string) (squad *Squad, err error) {
+	mss.RLock()
+	defer mss.RUnlock()
+	s, ok := mss.squads[squadId]
+	if !ok {
+		err = fmt.Errorf("no squad with id %s", squadId)
+		return
+	}
+	squad = copySquad(s)
+	return
+}
+
+func (mss *MemorySquadStore) GetSquads(ctx context.Context, limit int64, lastIndex int64) (squads []*Squad, err error) {
+	squads = mss.filter(limit, lastIndex, func(*Squad) bool { return true })
+	return
+}
+
+func (mss *MemorySquadStore) GetSquadsByName(ctx context.Context, pattern string, limit int64, lastIndex int64) (squads []*Squad, err error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return
+	}
+	squads = mss.filter(limit, lastIndex, func(s *Squad) bool { return re.MatchString(s.Name) })
+	return
+}
+
+func (mss *MemorySquadStore) GetSquadsByID(ctx context.Context, pattern string, limit int64, lastIndex int64) (squads []*Squad, err error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return
+	}
+	squads = mss.filter(limit, lastIndex, func(s *Squad) bool { return re.MatchString(s.ID) })
+	return
+}
+
+func (mss *MemorySquadStore) GetSquadsByOwner(ctx context.Context, owner string, limit int64, lastIndex int64) (squads []*Squad, err error) {
+	squads = mss.filter(limit, lastIndex, func(s *Squad) bool { return s.Owner == owner })
+	return
+}
+
+func (mss *MemorySquadStore) GetSquadsByHost(ctx context.Context, host string, limit int64, lastIndex int64) (squads []*Squad, err error) {
+	squads = mss.filter(limit, lastIndex, func(s *Squad) bool { return s.HostId == host })
+	return
+}
+
+func (mss *MemorySquadStore) DeleteSquad(ctx context.Context, squadId string) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	if _, ok := mss.squads[squadId]; !ok {
+		return
+	}
+	delete(mss.squads, squadId)
+	for i, id := range mss.order {
+		if id == squadId {
+			mss.order = append(mss.order[:i], mss.order[i+1:]...)
+			break
+		}
+	}
+	return
+}
+
+func (mss *MemorySquadStore) UpdateSquadName(ctx context.Context, squadId string, newName string) (err error) {
+	err = mss.update(squadId, func(s *Squad) { s.Name = newName })
+	return
+}
+
+func (mss *MemorySquadStore) UpdateSquadPassword(ctx context.Context, squadId string, newPassword string) (err error) {
+	err = mss.update(squadId, func(s *Squad) { s.Password = newPassword })
+	return
+}
+
+func (mss *MemorySquadStore) UpdateSquadStatus(ctx context.Context, squadId string, newStatus bool) (err error) {
+	err = mss.update(squadId, func(s *Squad) { s.Status = newStatus })
+	return
+}
+
+func (mss *MemorySquadStore) UpdateSquadMembers(ctx context.Context, squadId string, members []string) (err error) {
+	err = mss.update(squadId, func(s *Squad) { s.Members = append([]string{}, members...) })
+	return
+}
+
+func (mss *MemorySquadStore) UpdateSquadAuthorizedMembers(ctx context.Context, squadId string, authorizedMembers []string) (err error) {
+	err = mss.update(squadId, func(s *Squad) { s.AuthorizedMembers = append([]string{}, authorizedMembers...) })
+	return
+}
+
+func (mss *MemorySquadStore) UpdateSquadType(ctx context.Context, squadId string, squadType SquadType) (err error) {
+	err = mss.update(squadId, func(s *Squad) { s.SquadType = squadType })
+	return
+}
+
+func (mss *MemorySquadStore) UpdateSquadOwner(ctx context.Context, squadId string, owner string) (err error) {
+	err = mss.update(squadId, func(s *Squad) { s.Owner = owner })
+	return
+}
+
+func (mss *MemorySquadStore) UpdateSquadHost(ctx context.Context, squadId string, host string) (err error) {
+	err = mss.update(squadId, func(s *Squad) { s.HostId = host })
+	return
+}
+
+func (mss *MemorySquadStore) UpdateSquadMeshLimit(ctx context.Context, squadId string, limit int) (err error) {
+	err = mss.update(squadId, func(s *Squad) { s.MeshLimit = limit })
+	return
+}
+
+func (mss *MemorySquadStore) UpdateSquadRoles(ctx context.Context, squadId string, roles SquadRoles) (err error) {
+	err = mss.update(squadId, func(s *Squad) { s.Roles = copyRoles(roles) })
+	return
+}
+
+func (mss *MemorySquadStore) AddSquadInvite(ctx context.Context, invite *SquadInvite) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	if _, ok := mss.invites[invite.Code]; ok {
+		err = fmt.Errorf("An invite with code %s already exist", invite.Code)
+		return
+	}
+	i := *invite
+	mss.invites[invite.Code] = &i
+	return
+}
+
+func (mss *MemorySquadStore) GetSquadInvite(ctx context.Context, code string) (invite *SquadInvite, err error) {
+	mss.RLock()
+	defer mss.RUnlock()
+	i, ok := mss.invites[code]
+	if !ok {
+		err = fmt.Errorf("no invite with code %s", code)
+		return
+	}
+	c := *i
+	invite = &c
+	return
+}
+
+func (mss *MemorySquadStore) GetSquadInvites(ctx context.Context, squadId string) (invites []*SquadInvite, err error) {
+	mss.RLock()
+	defer mss.RUnlock()
+	invites = make([]*SquadInvite, 0)
+	for _, i := range mss.invites {
+		if i.SquadId == squadId {
+			c := *i
+			invites = append(invites, &c)
+		}
+	}
+	sort.Slice(invites, func(a, b int) bool { return invites[a].CreatedAt < invites[b].CreatedAt })
+	return
+}
+
+func (mss *MemorySquadStore) UseSquadInvite(ctx context.Context, code string, now time.Time) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	invite, ok := mss.invites[code]
+	if !ok || !invite.Valid(now) {
+		err = fmt.Errorf("this invite is no longer valid")
+		return
+	}
+	invite.Uses++
+	return
+}
+
+func (mss *MemorySquadStore) RevokeSquadInvite(ctx context.Context, code string) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	if invite, ok := mss.invites[code]; ok {
+		invite.Revoked = true
+	}
+	return
+}
+
+func (mss *MemorySquadStore) GetSquadsByRole(ctx context.Context, peerId string, role SquadRole, limit int64, lastIndex int64) (squads []*Squad, err error) {
+	squads = mss.filter(limit, lastIndex, func(s *Squad) bool { return s.Roles[peerId] == role })
+	return
+}
+
+func (mss *MemorySquadStore) GetSquadsByMember(ctx context.Context, peerId string, limit int64, lastIndex int64) (squads []*Squad, err error) {
+	squads = mss.filter(limit, lastIndex, func(s *Squad) bool {
+		return s.Owner == peerId || containsPeer(s.Members, peerId) || containsPeer(s.AuthorizedMembers, peerId)
+	})
+	return
+}
+
+func (mss *MemorySquadStore) AddSquadJoinRequest(ctx context.Context, request *SquadJoinRequest) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	r := *request
+	mss.joinRequests = append(mss.joinRequests, &r)
+	return
+}
+
+func (mss *MemorySquadStore) GetSquadJoinRequest(ctx context.Context, requestId string) (request *SquadJoinRequest, err error) {
+	mss.RLock()
+	defer mss.RUnlock()
+	for _, r := range mss.joinRequests {
+		if r.Id == requestId {
+			c := *r
+			request = &c
+			return
+		}
+	}
+	err = fmt.Errorf("no join request with id %s", requestId)
+	return
+}
+
+func (mss *MemorySquadStore) GetSquadJoinRequests(ctx context.Context, squadId string) (requests []*SquadJoinRequest, err error) {
+	mss.RLock()
+	defer mss.RUnlock()
+	requests = make([]*SquadJoinRequest, 0)
+	for _, r := range mss.joinRequests {
+		if r.SquadId == squadId {
+			c := *r
+			requests = append(requests, &c)
+		}
+	}
+	return
+}
+
+func (mss *MemorySquadStore) DeleteSquadJoinRequest(ctx context.Context, requestId string) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	for i, r := range mss.joinRequests {
+		if r.Id == requestId {
+			mss.joinRequests = append(mss.joinRequests[:i], mss.joinRequests[i+1:]...)
+			break
+		}
+	}
+	return
+}
+
+func (mps *MemoryPeerStore) filter(limit int64, lastIndex int64, match func(*Peer) bool) (peers []*Peer) {
+	mps.RLock()
+	defer mps.RUnlock()
+	matching := make([]*Peer, 0)
+	for _, id := range mps.order {
+		if peer := mps.peers[id]; match(peer) {
+			matching = append(matching, peer)
+		}
+	}
+	start, end := paginate(len(matching), limit, lastIndex)
+	peers = make([]*Peer, 0, end-start)
+	for _, peer := range matching[start:end] {
+		peers = append(peers, proto.Clone(peer).(*Peer))
+	}
+	return
+}
+
+func (mps *MemoryPeerStore) update(peerId string, apply func(*Peer)) (err error) {
+	mps.Lock()
+	defer mps.Unlock()
+	if peer, ok := mps.peers[peerId]; ok {
+		apply(peer)
+	}
+	return
+}
+
+func (mps *MemoryPeerStore) AddNewPeer(ctx context.Context, peer *Peer) (err error) {
+	mps.Lock()
+	defer mps.Unlock()
+	if _, ok := mps.peers[peer.Id]; ok {
+		err = fmt.Errorf("A peer with id %s already exist", peer.Id)
+		return
+	}
+	mps.peers[peer.Id] = proto.Clone(peer).(*Peer)
+	mps.order = append(mps.order, peer.Id)
+	return
+}
+
+func (mps *MemoryPeerStore) GetPeer(ctx context.Context, peerId string) (peer *Peer, err error) {
+	mps.RLock()
+	defer mps.RUnlock()
+	p, ok := mps.peers[peerId]
+	if !ok {
+		err = fmt.Errorf("no peer with id %s", peerId)
+		return
+	}
+	peer = proto.Clone(p).(*Peer)
+	return
+}
+
+func (mps *MemoryPeerStore) GetPeers(ctx context.Context, limit int64, lastIndex int64) (peers []*Peer, err error) {
+	peers = mps.filter(limit, lastIndex, func(*Peer) bool { return true })
+	return
+}
+
+func (mps *MemoryPeerStore) GetPeersByName(ctx context.Context, pattern string, limit int64, lastIndex int64) (peers []*Peer, err error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return
+	}
+	peers = mps.filter(limit, lastIndex, func(p *Peer) bool { return re.MatchString(p.Name) })
+	return
+}
+
+func (mps *MemoryPeerStore) GetPeersByID(ctx context.Context, pattern string, limit int64, lastIndex int64) (peers []*Peer, err error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return
+	}
+	peers = mps.filter(limit, lastIndex, func(p *Peer) bool { return re.MatchString(p.Id) })
+	return
+}
+
+func (mps *MemoryPeerStore) DeletePeer(ctx context.Context, peerId string) (err error) {
+	mps.Lock()
+	defer mps.Unlock()
+	if _, ok := mps.peers[peerId]; !ok {
+		return
+	}
+	delete(mps.peers, peerId)
+	for i, id := range mps.order {
+		if id == peerId {
+			mps.order = append(mps.order[:i], mps.order[i+1:]...)
+			break
+		}
+	}
+	return
+}
+
+func (mps *MemoryPeerStore) UpdatePeerName(ctx context.Context, peerId string, newName string) (err error) {
+	err = mps.update(peerId, func(p *Peer) { p.Name = newName })
+	return
+}
+
+func (mps *MemoryPeerStore) UpdatePeerStatus(ctx context.Context, peerId string, newStatus bool) (err error) {
+	err = mps.update(peerId, func(p *Peer) { p.Active = newStatus })
+	return
+}
+
+func (mps *MemoryPeerStore) UpdatePeerPresence(ctx context.Context, peerId string, status PresenceStatus, lastSeen int64) (err error) {
+	err = mps.update(peerId, func(p *Peer) {
+		p.Active, p.Status, p.LastSeen = status != OFFLINE, string(status), lastSeen
+	})
+	return
+}
+
+func (mps *MemoryPeerStore) UpdatePeerKeys(ctx context.Context, peerId string, keys []*PeerKey) (err error) {
+	err = mps.update(peerId, func(p *Peer) {
+		p.Keys = make([]*PeerKey, 0, len(keys))
+		for _, key := range keys {
+			p.Keys = append(p.Keys, proto.Clone(key).(*PeerKey))
+		}
+	})
+	return
+}
+
+func (mps *MemoryPeerStore) UpdatePeerFriends(ctx context.Context, peerId string, friends []string) (err error) {
+	err = mps.update(peerId, func(p *Peer) { p.Friends = append([]string{}, friends...) })
+	return
+}
+
+func (mps *MemoryPeerStore) UpdatePeerBlocked(ctx context.Context, peerId string, blocked []string) (err error) {
+	err = mps.update(peerId, func(p *Peer) { p.Blocked = append([]string{}, blocked...) })
+	return
+}
+
+func (mps *MemoryPeerStore) AddFriendRequest(ctx context.Context, request *FriendRequest) (err error) {
+	mps.Lock()
+	defer mps.Unlock()
+	mps.friendRequests = append(mps.friendRequests, proto.Clone(request).(*FriendRequest))
+	return
+}
+
+func (mps *MemoryPeerStore) GetFriendRequest(ctx context.Context, requestId string) (request *FriendRequest, err error) {
+	mps.RLock()
+	defer mps.RUnlock()
+	for _, r := range mps.friendRequests {
+		if r.Id == requestId {
+			request = proto.Clone(r).(*FriendRequest)
+			return
+		}
+	}
+	err = fmt.Errorf("no friend request with id %s", requestId)
+	return
+}
+
+func (mps *MemoryPeerStore) GetFriendRequests(ctx context.Context, peerId string) (requests []*FriendRequest, err error) {
+	mps.RLock()
+	defer mps.RUnlock()
+	requests = make([]*FriendRequest, 0)
+	for _, r := range mps.friendRequests {
+		if r.From == peerId || r.To == peerId {
+			requests = append(requests, proto.Clone(r).(*FriendRequest))
+		}
+	}
+	return
+}
+
+func (mps *MemoryPeerStore) DeleteFriendRequest(ctx context.Context, requestId string) (err error) {
+	mps.Lock()
+	defer mps.Unlock()
+	for i, r := range mps.friendRequests {
+		if r.Id == requestId {
+			mps.friendRequests = append(mps.friendRequests[:i], mps.friendRequests[i+1:]...)
+			break
+		}
+	}
+	return
+}
+
+func (mss *MemorySessionStore) AddSession(ctx context.Context, session *Session) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	s := *session
+	mss.sessions[session.Token] = &s
+	return
+}
+
+func (mss *MemorySessionStore) GetSession(ctx context.Context, token string) (session *Session, err error) {
+	mss.RLock()
+	defer mss.RUnlock()
+	s, ok := mss.sessions[token]
+	if !ok {
+		err = fmt.Errorf("no session for this token")
+		return
+	}
+	c := *s
+	session = &c
+	return
+}
+
+func (mss *MemorySessionStore) GetSessions(ctx context.Context) (sessions []*Session, err error) {
+	mss.RLock()
+	defer mss.RUnlock()
+	sessions = make([]*Session, 0, len(mss.sessions))
+	for _, s := range mss.sessions {
+		c := *s
+		sessions = append(sessions, &c)
+	}
+	return
+}
+
+func (mss *MemorySessionStore) UpdateSessionExpiry(ctx context.Context, token string, lastSeen time.Time, expiresAt time.Time) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	if s, ok := mss.sessions[token]; ok {
+		s.LastSeen, s.ExpiresAt = lastSeen, expiresAt
+	}
+	return
+}
+
+func (mss *MemorySessionStore) DeleteSession(ctx context.Context, token string) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	delete(mss.sessions, token)
+	return
+}
+
+func (mss *MemorySessionStore) DeletePeerSessions(ctx context.Context, peerId string) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	for token, s := range mss.sessions {
+		if s.PeerId == peerId {
+			delete(mss.sessions, token)
+		}
+	}
+	return
+}
+
+func (mss *MemorySessionStore) DeleteKeySessions(ctx context.Context, peerId string, keyId string) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	for token, s := range mss.sessions {
+		if s.PeerId == peerId && s.KeyId == keyId {
+			delete(mss.sessions, token)
+		}
+	}
+	return
+}
+
+func (mss *MemorySessionStore) DeleteExpiredSessions(ctx context.Context, now time.Time) (err error) {
+	mss.Lock()
+	defer mss.Unlock()
+	for token, s := range mss.sessions {
+		if !now.Before(s.ExpiresAt) {
+			delete(mss.sessions, token)
+		}
+	}
+	return
+}
+
+func (mms *MemoryMessageStore) AddMessage(ctx context.Context, message *OfflineMessage) (err error) {
+	mms.Lock()
+	defer mms.Unlock()
+	for _, m := range mms.messages[message.To] {
+		if m.Id == message.Id {
+			err = fmt.Errorf("message %s already exists", message.Id)
+			return
+		}
+	}
+	mms.messages[message.To] = append(mms.messages[message.To], copyOfflineMessage(message))
+	sort.SliceStable(mms.messages[message.To], func(i, j int) bool {
+		return mms.messages[message.To][i].Sequence < mms.messages[message.To][j].Sequence
+	})
+	return
+}
+
+func (mms *MemoryMessageStore) GetMessages(ctx context.Context, peerId string, now time.Time) (messages []*OfflineMessage, err error) {
+	mms.RLock()
+	defer mms.RUnlock()
+	messages = make([]*OfflineMessage, 0, len(mms.messages[peerId]))
+	for _, m := range mms.messages[peerId] {
+		if now.Before(m.ExpiresAt) {
+			messages = append(messages, copyOfflineMessage(m))
+		}
+	}
+	return
+}
+
+func (mms *MemoryMessageStore) CountMessages(ctx context.Context, peerId string, now time.Time) (count int64, err error) {
+	mms.RLock()
+	defer mms.RUnlock()
+	for _, m := range mms.messages[peerId] {
+		if now.Before(m.ExpiresAt) {
+			count++
+		}
+	}
+	return
+}
+
+func (mms *MemoryMessageStore) DeleteMessage(ctx context.Context, peerId string, messageId string) (err error) {
+	mms.Lock()
+	defer mms.Unlock()
+	for i, m := range mms.messages[peerId] {
+		if m.Id == messageId {
+			mms.messages[peerId] = append(mms.messages[peerId][:i], mms.messages[peerId][i+1:]...)
+			if len(mms.messages[peerId]) == 0 {
+				delete(mms.messages, peerId)
+			}
+			return
+		}
+	}
+	return
+}
+
+func (mms *MemoryMessageStore) DeleteExpiredMessages(ctx context.Context, now time.Time) (err error) {
+	mms.Lock()
+	defer mms.Unlock()
+	for peerId, messages := range mms.messages {
+		kept := messages[:0]
+		for _, m := range messages {
+			if now.Before(m.ExpiresAt) {
+				kept = append(kept, m)
+			}
+		}
+		if len(kept) == 0 {
+			delete(mms.messages, peerId)
+		} else {
+			mms.messages[peerId] = kept
+		}
+	}
+	return
+}
@@ -0,0 +1,169 @@
+package manager
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type MeshPairState string
+
+const (
+	PAIR_CONNECTING MeshPairState = "connecting"
+	PAIR_CONNECTED  MeshPairState = "connected"
+	PAIR_FAILED     MeshPairState = "failed"
+)
+
+const (
+	MESH_TOPOLOGY    SquadEvent = "mesh_topology"
+	MESH_RENEGOTIATE SquadEvent = "mesh_renegotiate"
+	MESH_PAIR_STATE  string     = "mesh_pair_state"
+)
+
+const DEFAULT_MESH_MAX_RENEGOTIATIONS = 3
+
+type meshPair struct {
+	offerer  string
+	answerer string
+	state    MeshPairState
+	attempts int
+}
+
+type MeshCoordinator struct {
+	Config MeshConfig
+	squads map[string]map[[2]string]*meshPair
+	*sync.Mutex
+}
+
+func NewMeshCoordinator(config MeshConfig) *MeshCoordinator {
+	return &MeshCoordinator{
+		Config: config,
+		squads: make(map[string]map[[2]string]*meshPair),
+		Mutex:  &sync.Mutex{},
+	}
+}
+
+func meshPairKey(peerId string, other string) [2]string {
+	if other < peerId {
+		return [2]string{other, peerId}
+	}
+	return [2]string{peerId, other}
+}
+
+func newMeshPair(peerId string, other string) *meshPair {
+	key := meshPairKey(peerId, other)
+	return &meshPair{offerer: key[0], answerer: key[1], state: PAIR_CONNECTING}
+}
+
+func (mc *MeshCoordinator) join(squadId string, peerId string, members []string) (offerTo []string, answerFrom []string) {
+	mc.Lock()
+	defer mc.Unlock()
+	pairs, ok := mc.squads[squadId]
+	if !ok {
+		pairs = make(map[[2]string]*meshPair)
+		mc.squads[squadId] = pairs
+	}
+	for _, member := range members {
+		if member == peerId {
+			continue
+		}
+		pair := newMeshPair(peerId, member)
+		pairs[meshPairKey(peerId, member)] = pair
+		if pair.offerer == peerId {
+			offerTo = append(offerTo, member)
+		} else {
+			answerFrom = append(answerFrom, member)
+		}
+	}
+	sort.Strings(offerTo)
+	sort.Strings(answerFrom)
+	return
+}
+
+func (mc *MeshCoordinator) leave(squadId string, peerId string) {
+	mc.Lock()
+	defer mc.Unlock()
+	for key := range mc.squads[squadId] {
+		if key[0] == peerId || key[1] == peerId {
+			delete(mc.squads[squadId], key)
+		}
+	}
+	if len(mc.squads[squadId]) == 0 {
+		delete(mc.squads, squadId)
+	}
+}
+
+func (mc *MeshCoordinator) remove(squadId string) {
+	mc.Lock()
+	defer mc.Unlock()
+	delete(mc.squads, squadId)
+}
+
+func (mc *MeshCoordinator) report(squadId string, peerId string, other string, state MeshPairState) (pair meshPair, renegotiate bool, err error) {
+	mc.Lock()
+	defer mc.Unlock()
+	p, ok := mc.squads[squadId][meshPairKey(peerId, other)]
+	if !ok {
+		err = fmt.Errorf("%s and %s are not paired in squad %s", peerId, other, squadId)
+		return
+	}
+	switch state {
+	case PAIR_CONNECTING:
+		p.state = state
+	case PAIR_CONNECTED:
+		p.state, p.attempts = state, 0
+	case PAIR_FAILED:
+		p.state = state
+		if p.attempts < mc.Config.MaxRenegotiations {
+			p.attempts++
+			p.state, renegotiate = PAIR_CONNECTING, true
+		}
+	default:
+		err = fmt.Errorf("unknown mesh pair state %s", state)
+		return
+	}
+	pair = *p
+	return
+}
+
+func (manager *Manager) meshJoined(squad *Squad, peerId string) {
+	if manager.migrateSquad(squad) || squad.NetworkType != MESH {
+		return
+	}
+	offerTo, answerFrom := manager.Mesh.join(squad.ID, peerId, squad.Members)
+	manager.notifySquad([]string{peerId}, "", MESH_TOPOLOGY, map[string]string{
+		"squadId":    squad.ID,
+		"offerTo":    strings.Join(offerTo, ","),
+		"answerFrom": strings.Join(answerFrom, ","),
+	})
+	for _, member := range answerFrom {
+		manager.notifySquad([]string{member}, peerId, MESH_TOPOLOGY, map[string]string{"squadId": squad.ID, "offerTo": peerId, "answerFrom": ""})
+	}
+	for _, member := range offerTo {
+		manager.notifySquad([]string{member}, peerId, MESH_TOPOLOGY, map[string]string{"squadId": squad.ID, "offerTo": "", "answerFrom": peerId})
+	}
+}
+
+func (manager *Manager) meshPairState(from string, payload map[string]string) {
+	squadId := payload["squadId"]
+	pair, renegotiate, err := manager.Mesh.report(squadId, from, payload["peerId"], MeshPairState(payload["state"]))
+	if err != nil {
+		if err = manager.send(&Envelope{
+			Type:    WS_ERROR,
+			To:      from,
+			Payload: map[string]string{"type": MESH_PAIR_STATE, "reason": err.Error()},
+		}); err != nil {
+			log.Println(err)
+		}
+		return
+	}
+	if !renegotiate {
+		return
+	}
+	attempt := strconv.Itoa(pair.attempts)
+	manager.notifySquad([]string{pair.offerer}, "", MESH_RENEGOTIATE, map[string]string{"squadId": squadId, "peerId": pair.answerer, "role": "offer", "attempt": attempt})
+	manager.notifySquad([]string{pair.answerer}, "", MESH_RENEGOTIATE, map[string]string{"squadId": squadId, "peerId": pair.offerer, "role": "answer", "attempt": attempt})
+}
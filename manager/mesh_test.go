@@ -0,0 +1,79 @@
+package manager
+
+import (
+	"testing"
+	"time"
+)
+
+func nextEvent(t *testing.T, stream *testLinkStream, eventType string) (res *Response) {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case res = <-stream.sent:
+			if res.Type == eventType {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("expected a %s event", eventType)
+		}
+	}
+}
+
+func TestMeshCoordinator(t *testing.T) {
+	m := NewMemoryManager()
+	m.Mesh.Config.MaxRenegotiations = 1
+	tokens := map[string]string{}
+	streams := map[string]*testLinkStream{}
+	for _, peer := range []string{"lolo", "lolo2", "lolo3"} {
+		tokens[peer] = newTestSession(t, m, peer)
+		stream, cancel := linkTestPeer(t, m, peer)
+		defer cancel()
+		streams[peer] = stream
+	}
+	if err := m.CreateSquad(tokens["lolo"], "0xff", "lolo", "test squad", PUBLIC, "", MESH, ""); err != nil {
+		t.Fatal(err)
+	}
+	for _, peer := range []string{"lolo2", "lolo3", "lolo"} {
+		if err := m.ConnectToSquad(tokens[peer], "0xff", peer, "", "", MESH); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if res := nextEvent(t, streams["lolo"], string(MESH_TOPOLOGY)); res.Payload["offerTo"] != "lolo2,lolo3" || res.Payload["answerFrom"] != "" {
+		t.Errorf("expected lolo to offer to lolo2 and lolo3, got %v", res.Payload)
+	}
+	if res := nextEvent(t, streams["lolo3"], string(MESH_TOPOLOGY)); res.Payload["answerFrom"] != "lolo2" {
+		t.Errorf("expected lolo3 to wait for the offer of lolo2, got %v", res.Payload)
+	}
+	if res := nextEvent(t, streams["lolo3"], string(MESH_TOPOLOGY)); res.Payload["answerFrom"] != "lolo" || res.Payload["offerTo"] != "" {
+		t.Errorf("expected lolo3 to wait for the offer of lolo, got %v", res.Payload)
+	}
+	m.meshPairState("lolo3", map[string]string{"squadId": "0xff", "peerId": "lolo2", "state": string(PAIR_FAILED)})
+	if res := nextEvent(t, streams["lolo2"], string(MESH_RENEGOTIATE)); res.Payload["peerId"] != "lolo3" || res.Payload["role"] != "offer" || res.Payload["attempt"] != "1" {
+		t.Errorf("expected lolo2 to offer again to lolo3, got %v", res.Payload)
+	}
+	if res := nextEvent(t, streams["lolo3"], string(MESH_RENEGOTIATE)); res.Payload["peerId"] != "lolo2" || res.Payload["role"] != "answer" {
+		t.Errorf("expected lolo3 to wait for a new offer of lolo2, got %v", res.Payload)
+	}
+	m.meshPairState("lolo2", map[string]string{"squadId": "0xff", "peerId": "lolo3", "state": string(PAIR_CONNECTED)})
+	m.meshPairState("lolo3", map[string]string{"squadId": "0xff", "peerId": "lolo2", "state": string(PAIR_FAILED)})
+	if res := nextEvent(t, streams["lolo2"], string(MESH_RENEGOTIATE)); res.Payload["peerId"] != "lolo3" || res.Payload["attempt"] != "1" {
+		t.Errorf("expected a connected pair to get its renegotiations back, got %v", res.Payload)
+	}
+	if res := nextEvent(t, streams["lolo3"], string(MESH_RENEGOTIATE)); res.Payload["peerId"] != "lolo2" {
+		t.Errorf("expected lolo3 to wait for a new offer of lolo2, got %v", res.Payload)
+	}
+	m.meshPairState("lolo2", map[string]string{"squadId": "0xff", "peerId": "lolo3", "state": string(PAIR_FAILED)})
+	select {
+	case res := <-streams["lolo2"].sent:
+		t.Errorf("expected no more renegotiation once the limit is reached, got %v", res)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if err := m.LeaveSquad(tokens["lolo2"], "0xff", "lolo2", MESH); err != nil {
+		t.Fatal(err)
+	}
+	m.meshPairState("lolo3", map[string]string{"squadId": "0xff", "peerId": "lolo2", "state": string(PAIR_CONNECTED)})
+	if res := nextEvent(t, streams["lolo3"], WS_ERROR); res.Payload["type"] != MESH_PAIR_STATE {
+		t.Errorf("expected the report on a removed pair to be rejected, got %v", res.Payload)
+	}
+}
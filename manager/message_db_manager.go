@@ -0,0 +1,64 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MessageDBManager struct {
+	*mongo.Collection
+}
+
+const MESSAGE_COLLECTION_NAME = "offline_messages"
+
+func NewMessageDBManager(uri string, dbName string) (messageDBManager *MessageDBManager, err error) {
+	messageDBManagerCh, errCh := make(chan *MessageDBManager), make(chan error)
+	go func() {
+		dbManagerCh, errC := NewDbManager(context.Background(), dbName, uri)
+		select {
+		case dbManager := <-dbManagerCh:
+			messageDBManagerCh <- &MessageDBManager{dbManager.Db.Collection(MESSAGE_COLLECTION_NAME)}
+		case e := <-errC:
+			errCh <- e
+		}
+	}()
+	select {
+	case err = <-errCh:
+		return
+	case messageDBManager = <-messageDBManagerCh:
+		return
+	}
+}
+
+func (mdm *MessageDBManager) AddMessage(ctx context.Context, message *OfflineMessage) (err error) {
+	_, err = mdm.InsertOne(ctx, message)
+	return
+}
+
+func (mdm *MessageDBManager) GetMessages(ctx context.Context, peerId string, now time.Time) (messages []*OfflineMessage, err error) {
+	res, err := mdm.Find(ctx, bson.M{"to": peerId, "expiresat": bson.M{"$gt": now}}, options.Find().SetSort(bson.M{"sequence": 1}))
+	if err != nil {
+		return
+	}
+	err = res.All(ctx, &messages)
+	return
+}
+
+func (mdm *MessageDBManager) CountMessages(ctx context.Context, peerId string, now time.Time) (count int64, err error) {
+	count, err = mdm.CountDocuments(ctx, bson.M{"to": peerId, "expiresat": bson.M{"$gt": now}})
+	return
+}
+
+func (mdm *MessageDBManager) DeleteMessage(ctx context.Context, peerId string, messageId string) (err error) {
+	_, err = mdm.DeleteOne(ctx, bson.M{"id": messageId, "to": peerId})
+	return
+}
+
+func (mdm *MessageDBManager) DeleteExpiredMessages(ctx context.Context, now time.Time) (err error) {
+	_, err = mdm.DeleteMany(ctx, bson.M{"expiresat": bson.M{"$lte": now}})
+	return
+}
@@ -0,0 +1,276 @@
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+type Envelope struct {
+	Type    string            `json:"type"`
+	From    string            `json:"from"`
+	To      string            `json:"to"`
+	Seq     uint64            `json:"seq,omitempty"`
+	Payload map[string]string `json:"payload"`
+	Signal  *Signal           `json:"signal,omitempty"`
+}
+
+type PeerConnection interface {
+	Send(envelope *Envelope) error
+	Close() error
+	Done() <-chan struct{}
+}
+
+type connectedPeer struct {
+	id   string
+	conn PeerConnection
+}
+
+type Router struct {
+	GRPCPeers map[string]*GRPCPeer
+	WSPeers   map[string]*WSPeer
+	Outbound  OutboundConfig
+	Heartbeat HeartbeatConfig
+	dropped   uint64
+	*sync.RWMutex
+}
+
+func NewRouter() *Router {
+	return &Router{
+		GRPCPeers: make(map[string]*GRPCPeer),
+		WSPeers:   make(map[string]*WSPeer),
+		Outbound:  DefaultConfig().Outbound,
+		Heartbeat: DefaultConfig().Heartbeat,
+		RWMutex:   &sync.RWMutex{},
+	}
+}
+
+func (router *Router) outboundQueue() *OutboundQueue {
+	return NewOutboundQueue(router.Outbound, func() { atomic.AddUint64(&router.dropped, 1) })
+}
+
+func (router *Router) DroppedMessages() uint64 {
+	return atomic.LoadUint64(&router.dropped)
+}
+
+func (router *Router) NewGRPCPeer(stream GrpcManager_LinkServer) (peer *GRPCPeer) {
+	peer = &GRPCPeer{
+		Conn:     stream,
+		State:    CONNECTED,
+		outbox:   router.outboundQueue(),
+		lastSeen: time.Now().UnixNano(),
+	}
+	go peer.outbox.run(func(envelope *Envelope) error {
+		return stream.Send(envelope.response())
+	}, func() error { return nil })
+	go peer.heartbeat(time.Duration(router.Heartbeat.PingInterval))
+	return
+}
+
+func (router *Router) NewWSPeer(conn *websocket.Conn) (peer *WSPeer) {
+	peer = &WSPeer{
+		Conn:     conn,
+		State:    WS_OPEN,
+		outbox:   router.outboundQueue(),
+		lastSeen: time.Now().UnixNano(),
+	}
+	conn.SetPongHandler(func(string) error {
+		peer.touch()
+		return nil
+	})
+	go peer.outbox.run(func(envelope *Envelope) error {
+		if err := conn.SetWriteDeadline(time.Now().Add(WS_WRITE_TIMEOUT)); err != nil {
+			return err
+		}
+		return conn.WriteJSON(envelope)
+	}, conn.Close)
+	go peer.ping(time.Duration(router.Heartbeat.PingInterval), time.Duration(router.Heartbeat.PongTimeout))
+	return
+}
+
+func (envelope *Envelope) response() *Response {
+	payload := make(map[string]string, len(envelope.Payload)+2)
+	for k, v := range envelope.Payload {
+		payload[k] = v
+	}
+	payload["from"], payload["to"] = envelope.From, envelope.To
+	if envelope.Seq > 0 {
+		payload["seq"] = strconv.FormatUint(envelope.Seq, 10)
+	}
+	return &Response{
+		Type:    envelope.Type,
+		Success: true,
+		Payload: payload,
+		Signal:  envelope.Signal,
+	}
+}
+
+func (peer *GRPCPeer) Send(envelope *Envelope) error {
+	return peer.outbox.push(envelope)
+}
+
+func (peer *GRPCPeer) Close() error {
+	peer.outbox.close()
+	return nil
+}
+
+func (peer *GRPCPeer) Done() <-chan struct{} {
+	return peer.outbox.done
+}
+
+func (peer *GRPCPeer) Dropped() uint64 {
+	return peer.outbox.Dropped()
+}
+
+func (peer *WSPeer) Send(envelope *Envelope) error {
+	if envelope.Payload == nil {
+		envelope = &Envelope{Type: envelope.Type, From: envelope.From, To: envelope.To, Seq: envelope.Seq, Payload: map[string]string{}, Signal: envelope.Signal}
+	}
+	return peer.outbox.push(envelope)
+}
+
+func (peer *WSPeer) Close() error {
+	peer.outbox.close()
+	return nil
+}
+
+func (peer *WSPeer) Done() <-chan struct{} {
+	return peer.outbox.done
+}
+
+func (peer *WSPeer) Dropped() uint64 {
+	return peer.outbox.Dropped()
+}
+
+func (router *Router) connection(peerId string) (conn PeerConnection, ok bool) {
+	router.RLock()
+	defer router.RUnlock()
+	if peer, found := router.GRPCPeers[peerId]; found {
+		return peer, true
+	}
+	if peer, found := router.WSPeers[peerId]; found {
+		return peer, true
+	}
+	return
+}
+
+func (router *Router) Connected(peerId string) bool {
+	_, ok := router.connection(peerId)
+	return ok
+}
+
+func (router *Router) PeerIds() (peerIds []string) {
+	router.RLock()
+	defer router.RUnlock()
+	peerIds = make([]string, 0, len(router.GRPCPeers)+len(router.WSPeers))
+	for id := range router.GRPCPeers {
+		peerIds = append(peerIds, id)
+	}
+	for id := range router.WSPeers {
+		if _, ok := router.GRPCPeers[id]; !ok {
+			peerIds = append(peerIds, id)
+		}
+	}
+	return
+}
+
+func (router *Router) connections() (peers []connectedPeer) {
+	router.RLock()
+	defer router.RUnlock()
+	peers = make([]connectedPeer, 0, len(router.GRPCPeers)+len(router.WSPeers))
+	for id, peer := range router.GRPCPeers {
+		peers = append(peers, connectedPeer{id, peer})
+	}
+	for id, peer := range router.WSPeers {
+		peers = append(peers, connectedPeer{id, peer})
+	}
+	return
+}
+
+func (router *Router) addGRPCPeer(peerId string, peer *GRPCPeer) (previous *GRPCPeer) {
+	router.Lock()
+	defer router.Unlock()
+	previous = router.GRPCPeers[peerId]
+	router.GRPCPeers[peerId] = peer
+	return
+}
+
+func (router *Router) addWSPeer(peerId string, peer *WSPeer) (previous *WSPeer) {
+	router.Lock()
+	defer router.Unlock()
+	previous = router.WSPeers[peerId]
+	router.WSPeers[peerId] = peer
+	return
+}
+
+func (router *Router) removeGRPCPeer(peerId string, peer *GRPCPeer) (removed bool) {
+	router.Lock()
+	defer router.Unlock()
+	if current, ok := router.GRPCPeers[peerId]; ok && current == peer {
+		delete(router.GRPCPeers, peerId)
+		removed = true
+	}
+	return
+}
+
+func (router *Router) removeWSPeer(peerId string, peer *WSPeer) (removed bool) {
+	router.Lock()
+	defer router.Unlock()
+	if current, ok := router.WSPeers[peerId]; ok && current == peer {
+		delete(router.WSPeers, peerId)
+		removed = true
+	}
+	return
+}
+
+func (manager *Manager) route(envelope *Envelope) (err error) {
+	envelope, buffered := manager.Resume.record(envelope)
+	if buffered {
+		return
+	}
+	err = manager.send(envelope)
+	return
+}
+
+func (manager *Manager) send(envelope *Envelope) (err error) {
+	conn, ok := manager.Router.connection(envelope.To)
+	if !ok && manager.Cluster != nil {
+		err = manager.forwardToNode(envelope)
+		return
+	}
+	if !ok {
+		err = fmt.Errorf("no corresponding peer for id %s", envelope.To)
+		return
+	}
+	if err = conn.Send(envelope); err != nil && !errors.Is(err, ErrMessageDropped) {
+		log.Printf("dropping connection of %s: %v\n", envelope.To, err)
+		manager.dropConnection(envelope.To, conn)
+	}
+	return
+}
+
+func (manager *Manager) forward(envelope *Envelope) (err error) {
+	if manager.isBlocked(envelope.To, envelope.From) {
+		return
+	}
+	err = manager.deliver(envelope)
+	return
+}
+
+func (manager *Manager) dropConnection(peerId string, conn PeerConnection) {
+	switch peer := conn.(type) {
+	case *GRPCPeer:
+		manager.RemoveGrpcPeer(peerId, peer)
+	case *WSPeer:
+		manager.RemoveWSPeer(peerId, peer)
+	}
+	if err := conn.Close(); err != nil {
+		log.Println(err)
+	}
+}
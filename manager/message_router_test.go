@@ -0,0 +1,67 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRouterEnvelopes(t *testing.T) {
+	m := NewMemoryManager()
+	url := newTestWSServer(t, m)
+	befriendTestPeers(t, m, "lolo", "lolo2")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &testLinkStream{ctx: ctx, sent: make(chan *Response, 16)}
+	go m.AddGrpcPeer(stream, "lolo", &Request{})
+	for i := 0; i < 100 && !m.Router.Connected("lolo"); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	conn := dialTestWS(t, url+"?token="+newTestSession(t, m, "lolo2"))
+	if err := conn.WriteJSON(&ServRequest{Type: WS_INIT}); err != nil {
+		t.Fatal(err)
+	}
+	waitForWSPeer(t, m, "lolo2")
+	if err := conn.WriteJSON(&ServRequest{Type: "offer", To: "lolo", Payload: map[string]string{"sdp": "v=0"}}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case res := <-stream.sent:
+		if res.Type != "offer" || res.Payload["from"] != "lolo2" || res.Payload["to"] != "lolo" || res.Payload["sdp"] != "v=0" {
+			t.Errorf("unexpected gRPC envelope %v", res)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("lolo never received the offer")
+	}
+	if err := m.route(&Envelope{Type: "answer", From: "lolo", To: "lolo2", Payload: map[string]string{"sdp": "v=0"}}); err != nil {
+		t.Fatal(err)
+	}
+	msg := readTestWS(t, conn)
+	if msg["type"] != "answer" || msg["from"] != "lolo" || msg["to"] != "lolo2" || msg["payload"].(map[string]interface{})["sdp"] != "v=0" {
+		t.Errorf("unexpected WebSocket envelope %v", msg)
+	}
+}
+
+func TestRouterDropsDeadConnections(t *testing.T) {
+	m := NewMemoryManager()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &testLinkStream{ctx: ctx, sent: make(chan *Response, 16), err: fmt.Errorf("broken pipe")}
+	done := make(chan error, 1)
+	go func() { done <- m.AddGrpcPeer(stream, "lolo", &Request{}) }()
+	for i := 0; i < 100 && !m.Router.Connected("lolo"); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := m.route(&Envelope{Type: "offer", From: "lolo2", To: "lolo"}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the Link stream of the dead connection to end")
+	}
+	if m.Router.Connected("lolo") {
+		t.Error("expected the dead connection to be dropped")
+	}
+}
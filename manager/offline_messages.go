@@ -0,0 +1,192 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OfflineMessage struct {
+	Id        string
+	To        string
+	From      string
+	Type      string
+	Payload   map[string]string
+	Sequence  int64
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+const (
+	DIRECT_MESSAGE string = "text"
+	MESSAGE_ACK    string = "ack"
+)
+
+const DEFAULT_OFFLINE_MESSAGE_TTL = 7 * 24 * time.Hour
+
+const DEFAULT_OFFLINE_MAX_MESSAGES = 500
+
+var offlineMessageTypes = map[string]bool{
+	string(INCOMING_MEMBER):             true,
+	string(HOSTED_INCOMING_MEMBER):      true,
+	string(LEAVING_MEMBER):              true,
+	string(HOSTED_LEAVING_MEMBER):       true,
+	string(SQUAD_HOST_CHANGED):          true,
+	string(NETWORK_TYPE_CHANGED):        true,
+	string(KICKED_MEMBER):               true,
+	string(BANNED_MEMBER):               true,
+	string(SQUAD_ROLE_CHANGED):          true,
+	string(SQUAD_OWNER_CHANGED):         true,
+	string(SQUAD_JOIN_REQUEST_APPROVED): true,
+	string(SQUAD_JOIN_REQUEST_DENIED):   true,
+	string(FRIEND_REQUEST):              true,
+	string(FRIEND_REQUEST_ACCEPTED):     true,
+	string(FRIEND_REQUEST_DECLINED):     true,
+	string(FRIEND_REQUEST_CANCELLED):    true,
+	string(FRIEND_REMOVED):              true,
+	DIRECT_MESSAGE:                      true,
+}
+
+var serverMessageTypes = map[string]bool{
+	string(MESH_TOPOLOGY):      true,
+	string(MESH_RENEGOTIATE):   true,
+	string(PRESENCE_CHANGED):   true,
+	string(SQUAD_JOIN_REQUEST): true,
+	SESSION_RESUME:             true,
+	SERVER_DRAINING:            true,
+	ICE_SERVERS:                true,
+	HEARTBEAT:                  true,
+	WS_ERROR:                   true,
+	WS_SESSION_REPLACED:        true,
+}
+
+func isServerMessageType(messageType string) bool {
+	return serverMessageTypes[messageType] || (offlineMessageTypes[messageType] && messageType != DIRECT_MESSAGE)
+}
+
+var offlineSequence int64
+
+func nextOfflineSequence(now time.Time) int64 {
+	for {
+		last, next := atomic.LoadInt64(&offlineSequence), now.UnixNano()
+		if next <= last {
+			next = last + 1
+		}
+		if atomic.CompareAndSwapInt64(&offlineSequence, last, next) {
+			return next
+		}
+	}
+}
+
+func copyOfflineMessage(message *OfflineMessage) *OfflineMessage {
+	c := *message
+	c.Payload = make(map[string]string, len(message.Payload))
+	for k, v := range message.Payload {
+		c.Payload[k] = v
+	}
+	return &c
+}
+
+func (message *OfflineMessage) envelope() *Envelope {
+	payload := make(map[string]string, len(message.Payload)+1)
+	for k, v := range message.Payload {
+		payload[k] = v
+	}
+	payload["messageId"] = message.Id
+	return &Envelope{
+		Type:    message.Type,
+		From:    message.From,
+		To:      message.To,
+		Payload: payload,
+	}
+}
+
+func (manager *Manager) acceptDirectMessage(envelope *Envelope, now time.Time) (err error) {
+	if _, err = manager.PeerStore.GetPeer(context.Background(), envelope.To); err != nil {
+		err = fmt.Errorf("no peer with id %s", envelope.To)
+		return
+	}
+	if manager.isBlocked(envelope.To, envelope.From) || !manager.canSignal(envelope.From, envelope.To, envelope.Payload["squadId"]) {
+		err = fmt.Errorf("%s cannot leave messages to %s", envelope.From, envelope.To)
+		return
+	}
+	count, err := manager.MessageStore.CountMessages(context.Background(), envelope.To, now)
+	if err != nil {
+		return
+	}
+	if count >= int64(manager.OfflineMessageCap) {
+		err = fmt.Errorf("the offline queue of %s is full", envelope.To)
+	}
+	return
+}
+
+func (manager *Manager) storeOfflineMessage(envelope *Envelope) (err error) {
+	now := time.Now()
+	if envelope.Type == DIRECT_MESSAGE {
+		if err = manager.acceptDirectMessage(envelope, now); err != nil {
+			return
+		}
+	}
+	message := &OfflineMessage{
+		Id:        uuid.NewString(),
+		To:        envelope.To,
+		From:      envelope.From,
+		Type:      envelope.Type,
+		Payload:   envelope.Payload,
+		Sequence:  nextOfflineSequence(now),
+		CreatedAt: now,
+		ExpiresAt: now.Add(manager.OfflineMessageTTL),
+	}
+	err = manager.MessageStore.AddMessage(context.Background(), copyOfflineMessage(message))
+	return
+}
+
+func (manager *Manager) deliver(envelope *Envelope) (err error) {
+	if err = manager.route(envelope); err == nil || !offlineMessageTypes[envelope.Type] || manager.Router.Connected(envelope.To) {
+		return
+	}
+	err = manager.storeOfflineMessage(envelope)
+	return
+}
+
+func (manager *Manager) deliverOfflineMessages(peerId string) {
+	now := time.Now()
+	if err := manager.MessageStore.DeleteExpiredMessages(context.Background(), now); err != nil {
+		log.Println(err)
+	}
+	messages, err := manager.MessageStore.GetMessages(context.Background(), peerId, now)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, message := range messages {
+		if err = manager.route(message.envelope()); err != nil {
+			log.Println(err)
+			return
+		}
+	}
+}
+
+func (manager *Manager) AckOfflineMessages(peerId string, messageIds []string) (err error) {
+	for _, id := range messageIds {
+		if id == "" {
+			continue
+		}
+		if err = manager.MessageStore.DeleteMessage(context.Background(), peerId, id); err != nil {
+			err = fmt.Errorf("cannot acknowledge message %s : %v", id, err)
+			return
+		}
+	}
+	return
+}
+
+func (manager *Manager) ack(peerId string, payload map[string]string) {
+	if err := manager.AckOfflineMessages(peerId, strings.Split(payload["messageId"], ",")); err != nil {
+		log.Println(err)
+	}
+}
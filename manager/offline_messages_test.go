@@ -0,0 +1,90 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func linkTestPeer(t *testing.T, m *Manager, peerId string) (stream *testLinkStream, cancel context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream = &testLinkStream{ctx: ctx, sent: make(chan *Response, 16)}
+	go m.AddGrpcPeer(stream, peerId, &Request{})
+	for i := 0; i < 100 && !m.Router.Connected(peerId); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	return
+}
+
+func unlinkTestPeer(t *testing.T, m *Manager, peerId string, cancel context.CancelFunc) {
+	cancel()
+	for i := 0; i < 100 && m.Router.Connected(peerId); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestOfflineMessages(t *testing.T) {
+	m := NewMemoryManager()
+	befriendTestPeers(t, m, "lolo", "lolo2")
+	befriendTestPeers(t, m, "lolo", "lolo3")
+	for _, text := range []string{"first", "second"} {
+		if err := m.forward(&Envelope{Type: DIRECT_MESSAGE, From: "lolo", To: "lolo2", Payload: map[string]string{"text": text}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.forward(&Envelope{Type: "offer", From: "lolo", To: "lolo2", Payload: map[string]string{}}); err == nil {
+		t.Error("expected an offer to an offline peer not to be stored")
+	}
+	if err := m.forward(&Envelope{Type: DIRECT_MESSAGE, From: "lolo", To: "nobody", Payload: map[string]string{"text": "hi"}}); err == nil {
+		t.Error("expected a message to an unknown peer not to be stored")
+	}
+	if err := m.forward(&Envelope{Type: DIRECT_MESSAGE, From: "lolo3", To: "lolo2", Payload: map[string]string{"text": "hi"}}); err == nil {
+		t.Error("expected a message from a stranger not to be stored")
+	}
+	m.relay(&Envelope{Type: string(SQUAD_OWNER_CHANGED), From: "lolo", To: "lolo2", Payload: map[string]string{"squadId": "squad", "id": "lolo"}})
+	m.notifySquad([]string{"lolo", "lolo2"}, "lolo", KICKED_MEMBER, map[string]string{"squadId": "squad"})
+	stream, cancel := linkTestPeer(t, m, "lolo2")
+	ids := make([]string, 0, 3)
+	for _, expected := range []string{DIRECT_MESSAGE, DIRECT_MESSAGE, string(KICKED_MEMBER)} {
+		select {
+		case res := <-stream.sent:
+			if res.Type != expected || res.Payload["messageId"] == "" {
+				t.Fatalf("expected a stored %s message, got %v", expected, res)
+			}
+			ids = append(ids, res.Payload["messageId"])
+			if len(ids) == 1 && res.Payload["text"] != "first" {
+				t.Errorf("expected the messages to be delivered in order, got %v", res)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("lolo2 never received its %s message", expected)
+		}
+	}
+	if err := m.AckOfflineMessages("lolo2", ids[:2]); err != nil {
+		t.Fatal(err)
+	}
+	unlinkTestPeer(t, m, "lolo2", cancel)
+	stream, cancel = linkTestPeer(t, m, "lolo2")
+	defer cancel()
+	select {
+	case res := <-stream.sent:
+		if res.Payload["messageId"] != ids[2] {
+			t.Errorf("expected only the unacknowledged message to be redelivered, got %v", res)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the unacknowledged message was not redelivered")
+	}
+	m.OfflineMessageCap = 1
+	for i, text := range []string{"first", "second"} {
+		if err := m.forward(&Envelope{Type: DIRECT_MESSAGE, From: "lolo", To: "lolo3", Payload: map[string]string{"text": text}}); (err != nil) != (i == 1) {
+			t.Errorf("expected only the first message to fit in the queue of lolo3, got %v", err)
+		}
+	}
+	m.OfflineMessageCap = 10
+	m.OfflineMessageTTL = -time.Second
+	if err := m.forward(&Envelope{Type: DIRECT_MESSAGE, From: "lolo", To: "lolo3", Payload: map[string]string{}}); err != nil {
+		t.Fatal(err)
+	}
+	if messages, err := m.MessageStore.GetMessages(context.Background(), "lolo3", time.Now()); err != nil || len(messages) != 1 {
+		t.Errorf("expected expired messages not to be returned, got %v %v", messages, err)
+	}
+}
@@ -0,0 +1,146 @@
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type OverflowPolicy string
+
+const (
+	DROP_OLDEST OverflowPolicy = "drop_oldest"
+	DISCONNECT  OverflowPolicy = "disconnect"
+	BLOCK       OverflowPolicy = "block"
+)
+
+const (
+	DEFAULT_OUTBOUND_QUEUE_SIZE    = 256
+	DEFAULT_OUTBOUND_BLOCK_TIMEOUT = time.Second
+	WS_WRITE_TIMEOUT               = 10 * time.Second
+)
+
+var (
+	ErrConnectionClosed = errors.New("connection closed")
+	ErrSlowConsumer     = errors.New("slow consumer")
+	ErrMessageDropped   = errors.New("message dropped")
+)
+
+type OutboundQueue struct {
+	queue   chan *Envelope
+	policy  OverflowPolicy
+	timeout time.Duration
+	dropped uint64
+	onDrop  func()
+	closing chan struct{}
+	done    chan struct{}
+	once    *sync.Once
+	lock    *sync.Mutex
+}
+
+func NewOutboundQueue(config OutboundConfig, onDrop func()) *OutboundQueue {
+	return &OutboundQueue{
+		queue:   make(chan *Envelope, config.QueueSize),
+		policy:  OverflowPolicy(config.OverflowPolicy),
+		timeout: time.Duration(config.BlockTimeout),
+		onDrop:  onDrop,
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+		once:    &sync.Once{},
+		lock:    &sync.Mutex{},
+	}
+}
+
+func (q *OutboundQueue) drop() {
+	atomic.AddUint64(&q.dropped, 1)
+	if q.onDrop != nil {
+		q.onDrop()
+	}
+}
+
+func (q *OutboundQueue) Dropped() uint64 {
+	return atomic.LoadUint64(&q.dropped)
+}
+
+func (q *OutboundQueue) push(envelope *Envelope) (err error) {
+	select {
+	case <-q.closing:
+		return ErrConnectionClosed
+	default:
+	}
+	select {
+	case q.queue <- envelope:
+		return
+	default:
+	}
+	switch q.policy {
+	case DROP_OLDEST:
+		q.lock.Lock()
+		defer q.lock.Unlock()
+		for {
+			select {
+			case q.queue <- envelope:
+				return
+			default:
+			}
+			select {
+			case <-q.queue:
+				q.drop()
+			default:
+			}
+		}
+	case BLOCK:
+		timer := time.NewTimer(q.timeout)
+		defer timer.Stop()
+		select {
+		case q.queue <- envelope:
+		case <-timer.C:
+			q.drop()
+			err = fmt.Errorf("%w after waiting %s", ErrMessageDropped, q.timeout)
+		case <-q.closing:
+			err = ErrConnectionClosed
+		}
+	default:
+		q.drop()
+		err = ErrSlowConsumer
+	}
+	return
+}
+
+func (q *OutboundQueue) run(write func(*Envelope) error, closeConn func() error) {
+	defer close(q.done)
+	defer func() {
+		if err := closeConn(); err != nil {
+			log.Println(err)
+		}
+	}()
+	for {
+		select {
+		case envelope := <-q.queue:
+			if err := write(envelope); err != nil {
+				log.Println(err)
+				q.close()
+				return
+			}
+		case <-q.closing:
+			for {
+				select {
+				case envelope := <-q.queue:
+					if err := write(envelope); err != nil {
+						log.Println(err)
+						return
+					}
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (q *OutboundQueue) close() {
+	q.once.Do(func() { close(q.closing) })
+}
@@ -0,0 +1,74 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestOutboundQueuePolicies(t *testing.T) {
+	config := OutboundConfig{QueueSize: 2, OverflowPolicy: string(DROP_OLDEST), BlockTimeout: Duration(10 * time.Millisecond)}
+	q := NewOutboundQueue(config, nil)
+	for _, id := range []string{"1", "2", "3"} {
+		if err := q.push(&Envelope{Type: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if first := <-q.queue; first.Type != "2" || q.Dropped() != 1 {
+		t.Errorf("expected the oldest message to be dropped, got %s and %d drops", first.Type, q.Dropped())
+	}
+	config.OverflowPolicy = string(DISCONNECT)
+	q = NewOutboundQueue(config, nil)
+	q.push(&Envelope{})
+	q.push(&Envelope{})
+	if err := q.push(&Envelope{}); !errors.Is(err, ErrSlowConsumer) {
+		t.Errorf("expected a slow consumer error, got %v", err)
+	}
+	config.OverflowPolicy = string(BLOCK)
+	q = NewOutboundQueue(config, nil)
+	q.push(&Envelope{})
+	q.push(&Envelope{})
+	if err := q.push(&Envelope{}); !errors.Is(err, ErrMessageDropped) || q.Dropped() != 1 {
+		t.Errorf("expected the message to be dropped after the timeout, got %v", err)
+	}
+	q.close()
+	if err := q.push(&Envelope{}); !errors.Is(err, ErrConnectionClosed) {
+		t.Errorf("expected a closed queue to refuse messages, got %v", err)
+	}
+}
+
+func TestSlowConsumerIsDisconnected(t *testing.T) {
+	m := NewMemoryManager()
+	m.Router.Outbound = OutboundConfig{QueueSize: 1, OverflowPolicy: string(DISCONNECT)}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	slow := &testLinkStream{ctx: ctx, sent: make(chan *Response)}
+	fast := &testLinkStream{ctx: ctx, sent: make(chan *Response, 16)}
+	done := make(chan error, 1)
+	go func() { done <- m.AddGrpcPeer(slow, "lolo", &Request{}) }()
+	go m.AddGrpcPeer(fast, "lolo2", &Request{})
+	for i := 0; i < 100 && !(m.Router.Connected("lolo") && m.Router.Connected("lolo2")); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	var err error
+	for i := 0; i < 10 && err == nil; i++ {
+		err = m.route(&Envelope{Type: "offer", From: "lolo3", To: "lolo"})
+	}
+	if !errors.Is(err, ErrSlowConsumer) {
+		t.Fatalf("expected the slow consumer to overflow, got %v", err)
+	}
+	if m.Router.Connected("lolo") || m.Router.DroppedMessages() != 1 {
+		t.Errorf("expected lolo to be disconnected after one dropped message, got %d", m.Router.DroppedMessages())
+	}
+	if err = m.route(&Envelope{Type: "offer", From: "lolo3", To: "lolo2"}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-fast.sent:
+	case <-time.After(time.Second):
+		t.Error("expected lolo2 to keep receiving messages")
+	}
+	cancel()
+	<-done
+}
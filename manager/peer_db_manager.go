@@ -16,10 +16,12 @@ type PeerDBManager struct {
 
 const PEER_COLLECTION_NAME = "peers"
 
-func NewPeerDBManager(host string, port int) (peerDBManager *PeerDBManager, err error) {
+const FRIEND_REQUEST_COLLECTION_SUFFIX = "_friend_requests"
+
+func NewPeerDBManager(uri string, dbName string) (peerDBManager *PeerDBManager, err error) {
 	peerDBManagerCh, errCh := make(chan *PeerDBManager), make(chan error)
 	go func() {
-		dbManagerCh, errC := NewDbManager(context.Background(), DB_NAME, host, port)
+		dbManagerCh, errC := NewDbManager(context.Background(), dbName, uri)
 		select {
 		case dbManager := <-dbManagerCh:
 			peerDBManagerCh <- &PeerDBManager{dbManager.Db.Collection(PEER_COLLECTION_NAME)}
@@ -60,7 +62,7 @@ func (pdm *PeerDBManager) GetPeers(ctx context.Context, limit int64, lastIndex i
 }
 
 func (pdm *PeerDBManager) GetPeersByName(ctx context.Context, pattern string, limit int64, lastIndex int64) (peers []*Peer, err error) {
-	res, err := pdm.Find(ctx, bson.D{{"name", primitive.Regex{Pattern: pattern, Options: ""}}}, options.Find().SetLimit(limit).SetSkip(lastIndex))
+	res, err := pdm.Find(ctx, bson.D{{Key: "name", Value: primitive.Regex{Pattern: pattern, Options: ""}}}, options.Find().SetLimit(limit).SetSkip(lastIndex))
 	if err != nil {
 		return
 	}
@@ -69,7 +71,7 @@ func (pdm *PeerDBManager) GetPeersByName(ctx context.Context, pattern string, li
 }
 
 func (pdm *PeerDBManager) GetPeersByID(ctx context.Context, pattern string, limit int64, lastIndex int64) (peers []*Peer, err error) {
-	res, err := pdm.Find(ctx, bson.D{{"id", primitive.Regex{Pattern: pattern, Options: ""}}}, options.Find().SetLimit(limit).SetSkip(lastIndex))
+	res, err := pdm.Find(ctx, bson.D{{Key: "id", Value: primitive.Regex{Pattern: pattern, Options: ""}}}, options.Find().SetLimit(limit).SetSkip(lastIndex))
 	if err != nil {
 		return
 	}
@@ -83,15 +85,74 @@ func (pdm *PeerDBManager) DeletePeer(ctx context.Context, peerId string) (err er
 }
 
 func (pdm *PeerDBManager) UpdatePeerName(ctx context.Context, peerId string, newName string) (err error) {
-	_, err = pdm.UpdateOne(ctx, bson.M{"id": peerId}, bson.D{
-		{"$set", bson.D{{"name", newName}}},
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": peerId}, bson.M{
+		"$set": bson.M{"name": newName},
 	})
 	return
 }
 
 func (pdm *PeerDBManager) UpdatePeerStatus(ctx context.Context, peerId string, newStatus bool) (err error) {
-	_, err = pdm.UpdateOne(ctx, bson.M{"id": peerId}, bson.D{
-		{"$set", bson.D{{"status", newStatus}}},
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": peerId}, bson.M{
+		"$set": bson.M{"active": newStatus},
+	})
+	return
+}
+
+func (pdm *PeerDBManager) UpdatePeerPresence(ctx context.Context, peerId string, status PresenceStatus, lastSeen int64) (err error) {
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": peerId}, bson.M{
+		"$set": bson.M{"active": status != OFFLINE, "status": status, "lastseen": lastSeen},
+	})
+	return
+}
+
+func (pdm *PeerDBManager) UpdatePeerKeys(ctx context.Context, peerId string, keys []*PeerKey) (err error) {
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": peerId}, bson.M{
+		"$set": bson.M{"keys": keys},
+	})
+	return
+}
+
+func (pdm *PeerDBManager) UpdatePeerFriends(ctx context.Context, peerId string, friends []string) (err error) {
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": peerId}, bson.M{
+		"$set": bson.M{"friends": friends},
+	})
+	return
+}
+
+func (pdm *PeerDBManager) UpdatePeerBlocked(ctx context.Context, peerId string, blocked []string) (err error) {
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": peerId}, bson.M{
+		"$set": bson.M{"blocked": blocked},
 	})
 	return
 }
+
+func (pdm *PeerDBManager) friendRequests() *mongo.Collection {
+	return pdm.Database().Collection(pdm.Name() + FRIEND_REQUEST_COLLECTION_SUFFIX)
+}
+
+func (pdm *PeerDBManager) AddFriendRequest(ctx context.Context, request *FriendRequest) (err error) {
+	_, err = pdm.friendRequests().InsertOne(ctx, request)
+	return
+}
+
+func (pdm *PeerDBManager) GetFriendRequest(ctx context.Context, requestId string) (request *FriendRequest, err error) {
+	err = pdm.friendRequests().FindOne(ctx, bson.M{"id": requestId}).Decode(&request)
+	return
+}
+
+func (pdm *PeerDBManager) GetFriendRequests(ctx context.Context, peerId string) (requests []*FriendRequest, err error) {
+	res, err := pdm.friendRequests().Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"from": peerId},
+		bson.M{"to": peerId},
+	}}, options.Find().SetSort(bson.M{"createdat": 1}))
+	if err != nil {
+		return
+	}
+	err = res.All(ctx, &requests)
+	return
+}
+
+func (pdm *PeerDBManager) DeleteFriendRequest(ctx context.Context, requestId string) (err error) {
+	_, err = pdm.friendRequests().DeleteOne(ctx, bson.M{"id": requestId})
+	return
+}
@@ -0,0 +1,109 @@
+package manager
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+type Challenge struct {
+	Type      AuthType
+	Nonce     []byte
+	PublicKey crypto.PublicKey
+	ExpiresAt time.Time
+}
+
+const (
+	CHALLENGE_TTL        = time.Minute
+	CHALLENGE_NONCE_SIZE = 32
+	MIN_RSA_KEY_BITS     = 2048
+)
+
+func ParsePeerKey(peerKey string) (publicKey crypto.PublicKey, algorithm AuthType, err error) {
+	key := strings.TrimSpace(peerKey)
+	if !strings.HasPrefix(key, "-----BEGIN") {
+		sshKey, _, _, _, e := ssh.ParseAuthorizedKey([]byte(key))
+		if e != nil {
+			err = fmt.Errorf("invalid OpenSSH public key : %v", e)
+			return
+		}
+		cryptoKey, ok := sshKey.(ssh.CryptoPublicKey)
+		if !ok {
+			err = fmt.Errorf("unsupported OpenSSH key type %s", sshKey.Type())
+			return
+		}
+		publicKey = cryptoKey.CryptoPublicKey()
+		algorithm, err = signatureAlgorithm(publicKey)
+		return
+	}
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		err = fmt.Errorf("invalid PEM public key")
+		return
+	}
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		algorithm = RSA
+	case "PUBLIC KEY":
+		if publicKey, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			return
+		}
+		algorithm, err = signatureAlgorithm(publicKey)
+	default:
+		err = fmt.Errorf("unsupported PEM block %s", block.Type)
+	}
+	return
+}
+
+func signatureAlgorithm(publicKey crypto.PublicKey) (algorithm AuthType, err error) {
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		algorithm = ED25519
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() {
+			err = fmt.Errorf("unsupported ECDSA curve %s", key.Curve.Params().Name)
+			return
+		}
+		algorithm = ECDSA_P256
+	case *rsa.PublicKey:
+		if key.N.BitLen() < MIN_RSA_KEY_BITS {
+			err = fmt.Errorf("RSA keys must be at least %d bits long", MIN_RSA_KEY_BITS)
+			return
+		}
+		algorithm = RSA_PSS
+	default:
+		err = fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	return
+}
+
+func verifySignature(challenge *Challenge, signature []byte) (err error) {
+	digest := sha256.Sum256(challenge.Nonce)
+	valid := false
+	switch challenge.Type {
+	case ED25519:
+		valid = ed25519.Verify(challenge.PublicKey.(ed25519.PublicKey), challenge.Nonce, signature)
+	case ECDSA_P256:
+		valid = ecdsa.VerifyASN1(challenge.PublicKey.(*ecdsa.PublicKey), digest[:], signature)
+	case RSA_PSS:
+		valid = rsa.VerifyPSS(challenge.PublicKey.(*rsa.PublicKey), crypto.SHA256, digest[:], signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto}) == nil
+	default:
+		err = fmt.Errorf("%s is not a signature algorithm", challenge.Type)
+		return
+	}
+	if !valid {
+		err = fmt.Errorf("authentification failed invalid signature")
+	}
+	return
+}
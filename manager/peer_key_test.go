@@ -0,0 +1,177 @@
+package manager
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func encodePKIXKey(t *testing.T, publicKey crypto.PublicKey) string {
+	b, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b}))
+}
+
+func TestSignatureChallenge(t *testing.T) {
+	edPub, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecPriv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPriv, err := rsa.GenerateKey(rand.Reader, MIN_RSA_KEY_BITS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sshPub, err := ssh.NewPublicKey(edPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		name      string
+		key       string
+		algorithm AuthType
+		sign      func(nonce []byte) ([]byte, error)
+	}{
+		{"ed25519", encodePKIXKey(t, edPub), ED25519, func(nonce []byte) ([]byte, error) {
+			return ed25519.Sign(edPriv, nonce), nil
+		}},
+		{"openssh", string(ssh.MarshalAuthorizedKey(sshPub)), ED25519, func(nonce []byte) ([]byte, error) {
+			return ed25519.Sign(edPriv, nonce), nil
+		}},
+		{"ecdsa", encodePKIXKey(t, &ecPriv.PublicKey), ECDSA_P256, func(nonce []byte) ([]byte, error) {
+			digest := sha256.Sum256(nonce)
+			return ecdsa.SignASN1(rand.Reader, ecPriv, digest[:])
+		}},
+		{"rsa-pss", encodePKIXKey(t, &rsaPriv.PublicKey), RSA_PSS, func(nonce []byte) ([]byte, error) {
+			digest := sha256.Sum256(nonce)
+			return rsa.SignPSS(rand.Reader, rsaPriv, crypto.SHA256, digest[:], nil)
+		}},
+	} {
+		m := NewMemoryManager()
+		if err := m.CreatePeer("lolo", c.key, "lolo"); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if peer, _ := m.PeerStore.GetPeer(context.Background(), "lolo"); peer.KeyAlgorithm != string(c.algorithm) {
+			t.Errorf("%s: expected the key algorithm %s to be recorded, got %s", c.name, c.algorithm, peer.KeyAlgorithm)
+		}
+		nonce, authType, err := m.PeerAuthInit("lolo", "")
+		if err != nil || authType != c.algorithm {
+			t.Fatalf("%s: unexpected challenge %s %v", c.name, authType, err)
+		}
+		if _, _, err = m.PeerAuthVerif("lolo", "", []byte("forged")); err == nil {
+			t.Errorf("%s: expected a forged signature to be rejected", c.name)
+		}
+		if nonce, _, err = m.PeerAuthInit("lolo", ""); err != nil {
+			t.Fatal(err)
+		}
+		signature, err := c.sign(nonce)
+		if err != nil {
+			t.Fatal(err)
+		}
+		session, _, err := m.PeerAuthVerif("lolo", "", signature)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if err = m.authenticate(session.Token, "lolo"); err != nil {
+			t.Errorf("%s: %v", c.name, err)
+		}
+	}
+}
+
+func TestChallengeExpiry(t *testing.T) {
+	m := NewMemoryManager()
+	now := time.Now()
+	m.AuthManager.now = func() time.Time { return now }
+	if err := m.CreatePeer("lolo", PUB_KEY, "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := m.PeerAuthInit("lolo", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := m.PeerAuthInit("lolo", ""); err == nil {
+		t.Error("expected a pending challenge to block a new one")
+	}
+	now = now.Add(CHALLENGE_TTL)
+	if _, _, err := m.PeerAuthInit("lolo", ""); err != nil {
+		t.Errorf("expected an expired challenge to be replaced, got %v", err)
+	}
+}
+
+func TestParsePeerKeyRejectsInvalidKeys(t *testing.T) {
+	for _, key := range []string{"", "-----BEGIN PUBLIC KEY-----\nnot base64", "ssh-ed25519 AAAA"} {
+		if _, _, err := ParsePeerKey(key); err == nil {
+			t.Errorf("expected %q to be rejected", key)
+		}
+	}
+	if _, err := NewAuthManager(time.Hour, nil).GenerateAuthToken("lolo", "not a pem"); err == nil {
+		t.Error("expected an invalid PEM key to be rejected")
+	}
+}
+
+func TestPeerKeyRotation(t *testing.T) {
+	m := NewMemoryManager()
+	if err := m.CreatePeer("lolo", PUB_KEY, "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	desktop, err := m.AuthManager.IssueSession("lolo", DEFAULT_PEER_KEY_ID, "desktop_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPub, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.AddPeerKey(newTestSession(t, m, "lolo2"), "lolo", "laptop", encodePKIXKey(t, edPub)); err == nil {
+		t.Error("expected another peer to be unable to add a key")
+	}
+	key, err := m.AddPeerKey(desktop.Token, "lolo", "laptop", encodePKIXKey(t, edPub))
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce, authType, err := m.PeerAuthInit("lolo", key.Id)
+	if err != nil || authType != ED25519 {
+		t.Fatalf("expected an %s challenge for the laptop key, got %s %v", ED25519, authType, err)
+	}
+	laptop, _, err := m.PeerAuthVerif("lolo", key.Id, ed25519.Sign(edPriv, nonce))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if laptop.KeyId != key.Id {
+		t.Errorf("expected the session to be bound to %s, got %s", key.Id, laptop.KeyId)
+	}
+	keys, err := m.ListPeerKeys(laptop.Token, "lolo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[1].Label != "laptop" || keys[1].LastUsedAt == 0 {
+		t.Errorf("unexpected keys %v", keys)
+	}
+	if err = m.RevokePeerKey(laptop.Token, "lolo", DEFAULT_PEER_KEY_ID); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.authenticate(desktop.Token, "lolo"); err == nil {
+		t.Error("expected the sessions of the revoked key to be revoked")
+	}
+	if _, authType, err = m.PeerAuthInit("lolo", ""); err != nil || authType != ED25519 {
+		t.Errorf("expected the remaining key to be challenged, got %s %v", authType, err)
+	}
+	if err = m.RevokePeerKey(laptop.Token, "lolo", key.Id); err == nil {
+		t.Error("expected the last active key to be kept")
+	}
+}
@@ -0,0 +1,272 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type PresenceStatus string
+
+const (
+	ONLINE  PresenceStatus = "online"
+	AWAY    PresenceStatus = "away"
+	BUSY    PresenceStatus = "busy"
+	OFFLINE PresenceStatus = "offline"
+)
+
+const PRESENCE_CHANGED SquadEvent = "presence_changed"
+
+type PresenceTracker struct {
+	presences     map[string]*PeerPresence
+	subscribers   map[string]map[string]bool
+	subscriptions map[string]map[string]bool
+	*sync.RWMutex
+}
+
+func NewPresenceTracker() *PresenceTracker {
+	return &PresenceTracker{
+		presences:     make(map[string]*PeerPresence),
+		subscribers:   make(map[string]map[string]bool),
+		subscriptions: make(map[string]map[string]bool),
+		RWMutex:       &sync.RWMutex{},
+	}
+}
+
+func (presence *PeerPresence) payload() map[string]string {
+	return map[string]string{
+		"id":       presence.PeerId,
+		"status":   presence.Status,
+		"lastSeen": fmt.Sprint(presence.LastSeen),
+	}
+}
+
+func (pt *PresenceTracker) get(peerId string) (presence *PeerPresence, ok bool) {
+	pt.RLock()
+	defer pt.RUnlock()
+	p, ok := pt.presences[peerId]
+	if ok {
+		presence = &PeerPresence{PeerId: p.PeerId, Status: p.Status, LastSeen: p.LastSeen}
+	}
+	return
+}
+
+func (pt *PresenceTracker) set(peerId string, status PresenceStatus, now time.Time) (presence *PeerPresence, changed bool, subscribers []string) {
+	pt.Lock()
+	defer pt.Unlock()
+	previous, ok := pt.presences[peerId]
+	changed = !ok || previous.Status != string(status)
+	pt.presences[peerId] = &PeerPresence{PeerId: peerId, Status: string(status), LastSeen: now.Unix()}
+	presence = &PeerPresence{PeerId: peerId, Status: string(status), LastSeen: now.Unix()}
+	for subscriber := range pt.subscribers[peerId] {
+		subscribers = append(subscribers, subscriber)
+	}
+	return
+}
+
+func (pt *PresenceTracker) subscribe(subscriber string, peerId string) {
+	pt.Lock()
+	defer pt.Unlock()
+	if _, ok := pt.subscribers[peerId]; !ok {
+		pt.subscribers[peerId] = make(map[string]bool)
+	}
+	if _, ok := pt.subscriptions[subscriber]; !ok {
+		pt.subscriptions[subscriber] = make(map[string]bool)
+	}
+	pt.subscribers[peerId][subscriber] = true
+	pt.subscriptions[subscriber][peerId] = true
+}
+
+func (pt *PresenceTracker) unsubscribe(subscriber string, peerId string) {
+	pt.Lock()
+	defer pt.Unlock()
+	pt.remove(subscriber, peerId)
+}
+
+func (pt *PresenceTracker) unsubscribeAll(subscriber string) {
+	pt.Lock()
+	defer pt.Unlock()
+	for peerId := range pt.subscriptions[subscriber] {
+		pt.remove(subscriber, peerId)
+	}
+}
+
+func (pt *PresenceTracker) remove(subscriber string, peerId string) {
+	delete(pt.subscribers[peerId], subscriber)
+	if len(pt.subscribers[peerId]) == 0 {
+		delete(pt.subscribers, peerId)
+	}
+	delete(pt.subscriptions[subscriber], peerId)
+	if len(pt.subscriptions[subscriber]) == 0 {
+		delete(pt.subscriptions, subscriber)
+	}
+}
+
+func (pt *PresenceTracker) links(peerId string) (links [][2]string) {
+	pt.RLock()
+	defer pt.RUnlock()
+	for watched := range pt.subscriptions[peerId] {
+		links = append(links, [2]string{peerId, watched})
+	}
+	for subscriber := range pt.subscribers[peerId] {
+		links = append(links, [2]string{subscriber, peerId})
+	}
+	return
+}
+
+func (pt *PresenceTracker) subscribersOf(peerId string) (subscribers []string) {
+	pt.RLock()
+	defer pt.RUnlock()
+	for subscriber := range pt.subscribers[peerId] {
+		subscribers = append(subscribers, subscriber)
+	}
+	return
+}
+
+func (manager *Manager) updatePresence(peerId string, status PresenceStatus) (presence *PeerPresence) {
+	presence, changed, subscribers := manager.Presence.set(peerId, status, time.Now())
+	if err := manager.PeerStore.UpdatePeerPresence(context.Background(), peerId, status, presence.LastSeen); err != nil {
+		log.Println(err)
+	}
+	if changed {
+		manager.notifySquad(subscribers, peerId, PRESENCE_CHANGED, presence.payload())
+		manager.broadcast(string(PRESENCE_CHANGED), peerId, presence.payload())
+	}
+	return
+}
+
+func (manager *Manager) remotePresence(peerId string, payload map[string]string) {
+	lastSeen, err := strconv.ParseInt(payload["lastSeen"], 10, 64)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	manager.Presence.set(peerId, PresenceStatus(payload["status"]), time.Unix(lastSeen, 0))
+	manager.notifySquad(manager.Presence.subscribersOf(peerId), peerId, PRESENCE_CHANGED, payload)
+}
+
+func (manager *Manager) revalidatePresence(peerId string) {
+	for _, link := range manager.Presence.links(peerId) {
+		if allowed, err := manager.canWatchPresence(link[0], link[1]); err != nil {
+			log.Println(err)
+		} else if !allowed {
+			manager.Presence.unsubscribe(link[0], link[1])
+		}
+	}
+}
+
+func (manager *Manager) peerConnected(peerId string) {
+	if presence, ok := manager.Presence.get(peerId); ok && presence.Status != string(OFFLINE) {
+		return
+	}
+	manager.updatePresence(peerId, ONLINE)
+}
+
+func (manager *Manager) peerDisconnected(peerId string) {
+	if manager.Router.Connected(peerId) {
+		return
+	}
+	manager.Presence.unsubscribeAll(peerId)
+	manager.updatePresence(peerId, OFFLINE)
+}
+
+func (manager *Manager) SetPresence(token string, from string, status PresenceStatus) (presence *PeerPresence, err error) {
+	if err = manager.authenticate(token, from); err != nil {
+		return
+	}
+	switch status {
+	case ONLINE, AWAY, BUSY:
+	default:
+		err = fmt.Errorf("unknown presence status %s", status)
+		return
+	}
+	if !manager.Router.Connected(from) {
+		err = fmt.Errorf("peer %s must be connected to set its presence", from)
+		return
+	}
+	presence = manager.updatePresence(from, status)
+	return
+}
+
+func (manager *Manager) GetPresence(peerId string) (presence *PeerPresence, err error) {
+	if presence, ok := manager.Presence.get(peerId); ok {
+		return presence, nil
+	}
+	peer, err := manager.PeerStore.GetPeer(context.Background(), peerId)
+	if err != nil {
+		return
+	}
+	presence = &PeerPresence{PeerId: peerId, Status: string(OFFLINE), LastSeen: peer.LastSeen}
+	return
+}
+
+func (manager *Manager) canWatchPresence(from string, peerId string) (allowed bool, err error) {
+	if manager.isBlocked(peerId, from) {
+		return
+	}
+	peer, err := manager.PeerStore.GetPeer(context.Background(), from)
+	if err != nil {
+		return
+	}
+	for _, friend := range peer.Friends {
+		if friend == peerId {
+			allowed = true
+			return
+		}
+	}
+	for _, store := range []SquadStore{manager.SquadStore, manager.HostedSquadStore} {
+		squads, e := store.GetSquadsByMember(context.Background(), from, 0, 0)
+		if e != nil {
+			err = e
+			return
+		}
+		for _, squad := range squads {
+			if role := squad.Role(peerId); role != "" && role != BANNED {
+				allowed = true
+				return
+			}
+		}
+	}
+	return
+}
+
+func (manager *Manager) SubscribePresence(token string, from string, peers []string) (presences []*PeerPresence, err error) {
+	if err = manager.authenticate(token, from); err != nil {
+		return
+	}
+	for _, peerId := range peers {
+		allowed, e := manager.canWatchPresence(from, peerId)
+		if e != nil {
+			err = e
+			return
+		}
+		if !allowed {
+			err = fmt.Errorf("peer %s is neither a friend nor a squad co-member", peerId)
+			return
+		}
+	}
+	presences = make([]*PeerPresence, 0, len(peers))
+	for _, peerId := range peers {
+		manager.Presence.subscribe(from, peerId)
+		presence, e := manager.GetPresence(peerId)
+		if e != nil {
+			err = e
+			return
+		}
+		presences = append(presences, presence)
+	}
+	return
+}
+
+func (manager *Manager) UnsubscribePresence(token string, from string, peers []string) (err error) {
+	if err = manager.authenticate(token, from); err != nil {
+		return
+	}
+	for _, peerId := range peers {
+		manager.Presence.unsubscribe(from, peerId)
+	}
+	return
+}
@@ -0,0 +1,119 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+type testLinkStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *Response
+	err  error
+}
+
+func (s *testLinkStream) Send(res *Response) error {
+	if s.err != nil {
+		return s.err
+	}
+	select {
+	case s.sent <- res:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *testLinkStream) Recv() (*Request, error) {
+	<-s.ctx.Done()
+	return nil, s.ctx.Err()
+}
+
+func (s *testLinkStream) Context() context.Context {
+	return s.ctx
+}
+
+func expectPresence(t *testing.T, stream *testLinkStream, peerId string, status PresenceStatus) {
+	select {
+	case res := <-stream.sent:
+		if res.Type != string(PRESENCE_CHANGED) || res.Payload["id"] != peerId || res.Payload["status"] != string(status) {
+			t.Errorf("expected %s to be %s, got %v", peerId, status, res)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("no presence update for %s", peerId)
+	}
+}
+
+func TestPresence(t *testing.T) {
+	m := NewMemoryManager()
+	m.Resume.Config.GracePeriod = Duration(10 * time.Millisecond)
+	url := newTestWSServer(t, m)
+	tokens := map[string]string{}
+	for _, peer := range []string{"lolo", "lolo2", "lolo3"} {
+		if err := m.PeerStore.AddNewPeer(context.Background(), &Peer{Id: peer, Name: peer}); err != nil {
+			t.Fatal(err)
+		}
+		tokens[peer] = newTestSession(t, m, peer)
+	}
+	if err := m.CreateSquad(tokens["lolo"], "0xff", "lolo", "test squad", PUBLIC, "", HOSTED, "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.ConnectToSquad(tokens["lolo2"], "0xff", "lolo2", "", "", HOSTED); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &testLinkStream{ctx: ctx, sent: make(chan *Response, 16)}
+	go m.AddGrpcPeer(stream, "lolo", &Request{})
+	for i := 0; i < 100 && !m.Router.Connected("lolo"); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := m.SubscribePresence(tokens["lolo3"], "lolo3", []string{"lolo2"}); err == nil {
+		t.Error("expected a stranger to be unable to follow lolo2")
+	}
+	presences, err := m.SubscribePresence(tokens["lolo"], "lolo", []string{"lolo2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(presences) != 1 || presences[0].Status != string(OFFLINE) {
+		t.Errorf("expected lolo2 to be offline, got %v", presences)
+	}
+	conn := dialTestWS(t, url+"?token="+tokens["lolo2"])
+	if err = conn.WriteJSON(&ServRequest{Type: WS_INIT}); err != nil {
+		t.Fatal(err)
+	}
+	expectPresence(t, stream, "lolo2", ONLINE)
+	if _, err = m.SetPresence(tokens["lolo2"], "lolo2", "invisible"); err == nil {
+		t.Error("expected an unknown status to be rejected")
+	}
+	if _, err = m.SetPresence(tokens["lolo2"], "lolo2", BUSY); err != nil {
+		t.Fatal(err)
+	}
+	expectPresence(t, stream, "lolo2", BUSY)
+	conn.Close()
+	expectPresence(t, stream, "lolo2", OFFLINE)
+	peer, err := m.PeerStore.GetPeer(context.Background(), "lolo2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peer.Active || peer.Status != string(OFFLINE) || peer.LastSeen == 0 {
+		t.Errorf("unexpected stored presence %+v", peer)
+	}
+	if err = m.BanFromSquad(tokens["lolo"], "0xff", "lolo", "lolo2"); err != nil {
+		t.Fatal(err)
+	}
+	if links := m.Presence.links("lolo2"); len(links) != 0 {
+		t.Errorf("expected lolo to stop following lolo2 once it was banned from the squad, got %v", links)
+	}
+	cancel()
+	for i := 0; i < 100; i++ {
+		if presence, _ := m.GetPresence("lolo"); presence.Status == string(OFFLINE) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Error("expected lolo to be offline once its stream ended")
+}
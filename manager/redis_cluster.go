@@ -0,0 +1,383 @@
+package manager
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	REDIS_PEER_KEY_PREFIX = "zippytal:peer:"
+	REDIS_DIAL_TIMEOUT    = 5 * time.Second
+	REDIS_COMMAND_TIMEOUT = 5 * time.Second
+	REDIS_RECONNECT_DELAY = time.Second
+)
+
+const redisUnregisterScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) end return 0`
+
+type RedisError string
+
+func (e RedisError) Error() string {
+	return string(e)
+}
+
+type RedisConn struct {
+	addr     string
+	password string
+	conn     net.Conn
+	reader   *bufio.Reader
+	*sync.Mutex
+}
+
+func DialRedis(addr string, password string) (redisConn *RedisConn, err error) {
+	redisConn = &RedisConn{
+		addr:     addr,
+		password: password,
+		Mutex:    &sync.Mutex{},
+	}
+	redisConn.Lock()
+	defer redisConn.Unlock()
+	if err = redisConn.dial(); err != nil {
+		redisConn = nil
+	}
+	return
+}
+
+func (rc *RedisConn) dial() (err error) {
+	conn, err := net.DialTimeout("tcp", rc.addr, REDIS_DIAL_TIMEOUT)
+	if err != nil {
+		return
+	}
+	rc.conn, rc.reader = conn, bufio.NewReader(conn)
+	if rc.password == "" {
+		return
+	}
+	conn.SetDeadline(time.Now().Add(REDIS_COMMAND_TIMEOUT))
+	defer conn.SetDeadline(time.Time{})
+	if err = rc.writeCommand("AUTH", rc.password); err == nil {
+		_, err = readRedisReply(rc.reader)
+	}
+	if err != nil {
+		conn.Close()
+		rc.conn, rc.reader = nil, nil
+	}
+	return
+}
+
+func (rc *RedisConn) writeCommand(args ...string) (err error) {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '*')
+	buf = strconv.AppendInt(buf, int64(len(args)), 10)
+	buf = append(buf, '\r', '\n')
+	for _, arg := range args {
+		buf = append(buf, '$')
+		buf = strconv.AppendInt(buf, int64(len(arg)), 10)
+		buf = append(buf, '\r', '\n')
+		buf = append(buf, arg...)
+		buf = append(buf, '\r', '\n')
+	}
+	_, err = rc.conn.Write(buf)
+	return
+}
+
+func readRedisLine(reader *bufio.Reader) (line string, err error) {
+	line, err = reader.ReadString('\n')
+	if err != nil {
+		return
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		err = fmt.Errorf("malformed redis reply %q", line)
+		return
+	}
+	line = line[:len(line)-2]
+	return
+}
+
+func readRedisReply(reader *bufio.Reader) (reply interface{}, err error) {
+	line, err := readRedisLine(reader)
+	if err != nil {
+		return
+	}
+	if len(line) == 0 {
+		err = fmt.Errorf("empty redis reply")
+		return
+	}
+	switch line[0] {
+	case '+':
+		reply = line[1:]
+	case '-':
+		err = RedisError(line[1:])
+	case ':':
+		reply, err = strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, e := strconv.Atoi(line[1:])
+		if e != nil || n < 0 {
+			err = e
+			return
+		}
+		data := make([]byte, n+2)
+		if _, err = io.ReadFull(reader, data); err != nil {
+			return
+		}
+		reply = string(data[:n])
+	case '*':
+		n, e := strconv.Atoi(line[1:])
+		if e != nil || n < 0 {
+			err = e
+			return
+		}
+		replies := make([]interface{}, n)
+		for i := range replies {
+			if replies[i], err = readRedisReply(reader); err != nil {
+				return
+			}
+		}
+		reply = replies
+	default:
+		err = fmt.Errorf("unknown redis reply type %q", line[0])
+	}
+	return
+}
+
+func (rc *RedisConn) Do(ctx context.Context, args ...string) (reply interface{}, err error) {
+	rc.Lock()
+	defer rc.Unlock()
+	if rc.conn == nil {
+		if err = rc.dial(); err != nil {
+			return
+		}
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(REDIS_COMMAND_TIMEOUT)
+	}
+	rc.conn.SetDeadline(deadline)
+	defer func() {
+		var redisErr RedisError
+		if err != nil && !errors.As(err, &redisErr) {
+			rc.conn.Close()
+			rc.conn, rc.reader = nil, nil
+		} else {
+			rc.conn.SetDeadline(time.Time{})
+		}
+	}()
+	if err = rc.writeCommand(args...); err != nil {
+		return
+	}
+	reply, err = readRedisReply(rc.reader)
+	return
+}
+
+func (rc *RedisConn) Close() (err error) {
+	rc.Lock()
+	defer rc.Unlock()
+	if rc.conn != nil {
+		err = rc.conn.Close()
+	}
+	return
+}
+
+type RedisBus struct {
+	addr          string
+	password      string
+	conn          *RedisConn
+	subscriptions map[*redisSubscription]bool
+	*sync.Mutex
+}
+
+type redisSubscription struct {
+	conn   *RedisConn
+	closed bool
+	*sync.Mutex
+}
+
+func NewRedisBus(addr string, password string) (bus *RedisBus, err error) {
+	conn, err := DialRedis(addr, password)
+	if err != nil {
+		return
+	}
+	bus = &RedisBus{
+		addr:          addr,
+		password:      password,
+		conn:          conn,
+		subscriptions: make(map[*redisSubscription]bool),
+		Mutex:         &sync.Mutex{},
+	}
+	return
+}
+
+func (rb *RedisBus) Publish(ctx context.Context, subject string, data []byte) (err error) {
+	reply, err := rb.conn.Do(ctx, "PUBLISH", subject, string(data))
+	if err == nil && reply == int64(0) {
+		err = ErrNoSubscribers
+	}
+	return
+}
+
+func (rb *RedisBus) Subscribe(subject string, handler func(data []byte)) (unsubscribe func(), err error) {
+	conn, err := rb.subscribe(subject)
+	if err != nil {
+		return
+	}
+	subscription := &redisSubscription{conn: conn, Mutex: &sync.Mutex{}}
+	rb.Lock()
+	rb.subscriptions[subscription] = true
+	rb.Unlock()
+	go rb.listen(subject, subscription, handler)
+	unsubscribe = func() {
+		rb.Lock()
+		delete(rb.subscriptions, subscription)
+		rb.Unlock()
+		subscription.close()
+	}
+	return
+}
+
+func (rb *RedisBus) subscribe(subject string) (conn *RedisConn, err error) {
+	if conn, err = DialRedis(rb.addr, rb.password); err != nil {
+		return
+	}
+	if _, err = conn.Do(context.Background(), "SUBSCRIBE", subject); err != nil {
+		conn.Close()
+		conn = nil
+	}
+	return
+}
+
+func (rb *RedisBus) listen(subject string, subscription *redisSubscription, handler func(data []byte)) {
+	for {
+		conn, ok := subscription.current()
+		if !ok {
+			return
+		}
+		err := receiveRedisMessages(conn, handler)
+		if _, ok = subscription.current(); !ok {
+			return
+		}
+		log.Printf("redis subscription to %s lost : %v\n", subject, err)
+		for {
+			time.Sleep(REDIS_RECONNECT_DELAY)
+			if _, ok = subscription.current(); !ok {
+				return
+			}
+			if conn, err = rb.subscribe(subject); err == nil {
+				break
+			}
+			log.Println(err)
+		}
+		if !subscription.replace(conn) {
+			return
+		}
+	}
+}
+
+func receiveRedisMessages(conn *RedisConn, handler func(data []byte)) (err error) {
+	for {
+		reply, e := readRedisReply(conn.reader)
+		if e != nil {
+			return e
+		}
+		message, ok := reply.([]interface{})
+		if !ok || len(message) != 3 || message[0] != "message" {
+			continue
+		}
+		if data, ok := message[2].(string); ok {
+			handler([]byte(data))
+		}
+	}
+}
+
+func (rs *redisSubscription) current() (conn *RedisConn, ok bool) {
+	rs.Lock()
+	defer rs.Unlock()
+	return rs.conn, !rs.closed
+}
+
+func (rs *redisSubscription) replace(conn *RedisConn) bool {
+	rs.Lock()
+	defer rs.Unlock()
+	if rs.closed {
+		conn.Close()
+		return false
+	}
+	rs.conn = conn
+	return true
+}
+
+func (rs *redisSubscription) close() {
+	rs.Lock()
+	defer rs.Unlock()
+	if !rs.closed {
+		rs.closed = true
+		rs.conn.Close()
+	}
+}
+
+func (rb *RedisBus) Close() (err error) {
+	rb.Lock()
+	defer rb.Unlock()
+	for subscription := range rb.subscriptions {
+		subscription.close()
+		delete(rb.subscriptions, subscription)
+	}
+	err = rb.conn.Close()
+	return
+}
+
+type RedisDirectory struct {
+	conn *RedisConn
+	ttl  time.Duration
+}
+
+func NewRedisDirectory(addr string, password string, ttl time.Duration) (directory *RedisDirectory, err error) {
+	conn, err := DialRedis(addr, password)
+	if err != nil {
+		return
+	}
+	directory = &RedisDirectory{conn, ttl}
+	return
+}
+
+func redisPeerKey(peerId string) string {
+	return REDIS_PEER_KEY_PREFIX + peerId
+}
+
+func (rd *RedisDirectory) Register(ctx context.Context, peerId string, nodeId string) (err error) {
+	seconds := int64(rd.ttl / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	_, err = rd.conn.Do(ctx, "SET", redisPeerKey(peerId), nodeId, "EX", strconv.FormatInt(seconds, 10))
+	return
+}
+
+func (rd *RedisDirectory) Unregister(ctx context.Context, peerId string, nodeId string) (err error) {
+	_, err = rd.conn.Do(ctx, "EVAL", redisUnregisterScript, "1", redisPeerKey(peerId), nodeId)
+	return
+}
+
+func (rd *RedisDirectory) Lookup(ctx context.Context, peerId string) (nodeId string, err error) {
+	reply, err := rd.conn.Do(ctx, "GET", redisPeerKey(peerId))
+	if err != nil {
+		return
+	}
+	if reply == nil {
+		return
+	}
+	nodeId, ok := reply.(string)
+	if !ok {
+		err = errors.New("unexpected redis reply to GET")
+	}
+	return
+}
+
+func (rd *RedisDirectory) Close() error {
+	return rd.conn.Close()
+}
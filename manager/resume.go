@@ -0,0 +1,251 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const SESSION_RESUME string = "session"
+
+const (
+	DEFAULT_RESUME_GRACE_PERIOD = 30 * time.Second
+	DEFAULT_RESUME_BUFFER_SIZE  = 256
+)
+
+type resumableSession struct {
+	token     string
+	seq       uint64
+	buffer    []*Envelope
+	suspended bool
+	lostAt    uint64
+	timer     *time.Timer
+}
+
+type expiredSession struct {
+	peerId  string
+	pending []*Envelope
+}
+
+type ResumeTracker struct {
+	Config   ResumeConfig
+	sessions map[string]*resumableSession
+	*sync.Mutex
+}
+
+func NewResumeTracker(config ResumeConfig) *ResumeTracker {
+	return &ResumeTracker{
+		Config:   config,
+		sessions: make(map[string]*resumableSession),
+		Mutex:    &sync.Mutex{},
+	}
+}
+
+func (s *resumableSession) since(seq uint64) (envelopes []*Envelope) {
+	for _, envelope := range s.buffer {
+		if envelope.Seq > seq {
+			envelopes = append(envelopes, envelope)
+		}
+	}
+	return
+}
+
+func (rt *ResumeTracker) start(peerId string) (token string) {
+	rt.Lock()
+	defer rt.Unlock()
+	if previous, ok := rt.sessions[peerId]; ok && previous.timer != nil {
+		previous.timer.Stop()
+	}
+	token = uuid.NewString()
+	rt.sessions[peerId] = &resumableSession{token: token}
+	return
+}
+
+func (rt *ResumeTracker) resume(peerId string, token string, lastSeq uint64) (replay []*Envelope, err error) {
+	rt.Lock()
+	defer rt.Unlock()
+	session, ok := rt.sessions[peerId]
+	if !ok || session.token != token {
+		err = fmt.Errorf("no resumable session for peer %s", peerId)
+		return
+	}
+	if lastSeq > session.seq {
+		err = fmt.Errorf("sequence %d was never sent to peer %s", lastSeq, peerId)
+		return
+	}
+	if lastSeq < session.seq && (len(session.buffer) == 0 || session.buffer[0].Seq > lastSeq+1) {
+		err = fmt.Errorf("messages after sequence %d are no longer buffered for peer %s", lastSeq, peerId)
+		return
+	}
+	if session.timer != nil {
+		session.timer.Stop()
+		session.timer = nil
+	}
+	session.suspended = false
+	replay = session.since(lastSeq)
+	return
+}
+
+func (rt *ResumeTracker) record(envelope *Envelope) (sequenced *Envelope, buffered bool) {
+	rt.Lock()
+	defer rt.Unlock()
+	session, ok := rt.sessions[envelope.To]
+	if !ok {
+		return envelope, false
+	}
+	session.seq++
+	sequenced = &Envelope{
+		Type:    envelope.Type,
+		From:    envelope.From,
+		To:      envelope.To,
+		Seq:     session.seq,
+		Payload: envelope.Payload,
+		Signal:  envelope.Signal,
+	}
+	session.buffer = append(session.buffer, sequenced)
+	if len(session.buffer) > rt.Config.BufferSize {
+		session.buffer = session.buffer[len(session.buffer)-rt.Config.BufferSize:]
+	}
+	buffered = session.suspended
+	return
+}
+
+func (rt *ResumeTracker) suspend(peerId string, onExpire func(pending []*Envelope)) (suspended bool) {
+	rt.Lock()
+	defer rt.Unlock()
+	session, ok := rt.sessions[peerId]
+	if !ok {
+		return
+	}
+	session.suspended, session.lostAt, suspended = true, session.seq, true
+	session.timer = time.AfterFunc(time.Duration(rt.Config.GracePeriod), func() {
+		if pending, ok := rt.expire(peerId, session); ok {
+			onExpire(pending)
+		}
+	})
+	return
+}
+
+func (rt *ResumeTracker) expire(peerId string, session *resumableSession) (pending []*Envelope, ok bool) {
+	rt.Lock()
+	defer rt.Unlock()
+	if current, found := rt.sessions[peerId]; !found || current != session || !session.suspended {
+		return
+	}
+	delete(rt.sessions, peerId)
+	if session.timer != nil {
+		session.timer.Stop()
+	}
+	pending, ok = session.since(session.lostAt), true
+	return
+}
+
+func (rt *ResumeTracker) expireNow(peerId string) (pending []*Envelope, ok bool) {
+	rt.Lock()
+	session, found := rt.sessions[peerId]
+	rt.Unlock()
+	if !found {
+		return
+	}
+	pending, ok = rt.expire(peerId, session)
+	return
+}
+
+func (rt *ResumeTracker) expireAll() (expired []expiredSession) {
+	rt.Lock()
+	defer rt.Unlock()
+	for peerId, session := range rt.sessions {
+		if session.timer != nil {
+			session.timer.Stop()
+		}
+		if session.suspended {
+			expired = append(expired, expiredSession{peerId, session.since(session.lostAt)})
+		}
+		delete(rt.sessions, peerId)
+	}
+	return
+}
+
+func (manager *Manager) openSession(peerId string, payload map[string]string) {
+	if token := payload["resumeToken"]; token != "" {
+		lastSeq, _ := strconv.ParseUint(payload["lastSeq"], 10, 64)
+		replay, err := manager.Resume.resume(peerId, token, lastSeq)
+		if err == nil {
+			manager.registerPeer(peerId)
+			manager.peerConnected(peerId)
+			manager.sessionOpened(peerId, token, true)
+			manager.pushICEServers(peerId, payload["region"])
+			for _, envelope := range replay {
+				if err = manager.send(envelope); err != nil {
+					log.Println(err)
+					return
+				}
+			}
+			return
+		}
+		log.Println(err)
+	}
+	token := manager.Resume.start(peerId)
+	manager.registerPeer(peerId)
+	manager.peerConnected(peerId)
+	if payload["resumable"] == "true" || payload["resumeToken"] != "" {
+		manager.sessionOpened(peerId, token, false)
+	}
+	manager.pushICEServers(peerId, payload["region"])
+	manager.deliverPendingJoinRequests(peerId)
+	manager.deliverOfflineMessages(peerId)
+}
+
+func (manager *Manager) sessionOpened(peerId string, token string, resumed bool) {
+	if err := manager.send(&Envelope{
+		Type: SESSION_RESUME,
+		To:   peerId,
+		Payload: map[string]string{
+			"resumeToken": token,
+			"resumed":     strconv.FormatBool(resumed),
+		},
+	}); err != nil {
+		log.Println(err)
+	}
+}
+
+func (manager *Manager) peerLost(peerId string) {
+	if manager.Router.Connected(peerId) || manager.stopped() {
+		return
+	}
+	if !manager.Resume.suspend(peerId, func(pending []*Envelope) {
+		manager.sessionExpired(peerId, pending)
+	}) {
+		manager.sessionExpired(peerId, nil)
+	}
+}
+
+func (manager *Manager) expireSession(peerId string) {
+	if pending, ok := manager.Resume.expireNow(peerId); ok {
+		manager.sessionExpired(peerId, pending)
+	}
+}
+
+func (manager *Manager) sessionExpired(peerId string, pending []*Envelope) {
+	if manager.Router.Connected(peerId) {
+		return
+	}
+	manager.unregisterPeer(context.Background(), peerId)
+	manager.peerDisconnected(peerId)
+	manager.leaveSquads(peerId)
+	manager.hostLost(peerId)
+	manager.ICE.stop(peerId)
+	for _, envelope := range pending {
+		if !offlineMessageTypes[envelope.Type] {
+			continue
+		}
+		if err := manager.storeOfflineMessage(envelope); err != nil {
+			log.Println(err)
+		}
+	}
+}
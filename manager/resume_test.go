@@ -0,0 +1,80 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSessionResume(t *testing.T) {
+	m := NewMemoryManager()
+	url := newTestWSServer(t, m)
+	if err := m.SquadStore.AddNewSquad(context.Background(), &Squad{ID: "squad", Owner: "lolo", Members: []string{"lolo", "lolo2"}, NetworkType: MESH}); err != nil {
+		t.Fatal(err)
+	}
+	stream, cancel := linkTestPeer(t, m, "lolo")
+	defer cancel()
+	token := newTestSession(t, m, "lolo2")
+	conn := dialTestWS(t, url+"?token="+token)
+	if err := conn.WriteJSON(&ServRequest{Type: WS_INIT, Payload: map[string]string{"resumable": "true"}}); err != nil {
+		t.Fatal(err)
+	}
+	session := readTestWS(t, conn)
+	payload, _ := session["payload"].(map[string]interface{})
+	if session["type"] != SESSION_RESUME || payload["resumed"] != "false" || payload["resumeToken"] == "" {
+		t.Fatalf("expected a resume token, got %v", session)
+	}
+	if err := m.forward(&Envelope{Type: "offer", From: "lolo", To: "lolo2", Payload: map[string]string{}}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := readTestWS(t, conn); msg["seq"] != float64(1) {
+		t.Fatalf("expected the first message to be numbered, got %v", msg)
+	}
+	conn.Close()
+	for i := 0; i < 100 && m.Router.Connected("lolo2"); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	for _, kind := range []string{"answer", DIRECT_MESSAGE} {
+		if err := m.forward(&Envelope{Type: kind, From: "lolo", To: "lolo2", Payload: map[string]string{}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	conn = dialTestWS(t, url+"?token="+token)
+	if err := conn.WriteJSON(&ServRequest{Type: WS_INIT, Payload: map[string]string{
+		"resumeToken": payload["resumeToken"].(string),
+		"lastSeq":     "1",
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := readTestWS(t, conn); msg["type"] != SESSION_RESUME || msg["payload"].(map[string]interface{})["resumed"] != "true" {
+		t.Fatalf("expected the session to be resumed, got %v", msg)
+	}
+	for i, kind := range []string{"answer", DIRECT_MESSAGE} {
+		if msg := readTestWS(t, conn); msg["type"] != kind || msg["seq"] != float64(i+2) {
+			t.Errorf("expected %s to be replayed as message %d, got %v", kind, i+2, msg)
+		}
+	}
+	squad, err := m.SquadStore.GetSquad(context.Background(), "squad")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !containsPeer(squad.Members, "lolo2") {
+		t.Error("expected lolo2 to stay in its squad across the reconnection")
+	}
+	if presence, _ := m.GetPresence("lolo2"); presence.Status != string(ONLINE) {
+		t.Errorf("expected lolo2 to stay online, got %v", presence)
+	}
+	select {
+	case res := <-stream.sent:
+		t.Errorf("expected the other members not to be notified, got %v", res)
+	default:
+	}
+	other := dialTestWS(t, url+"?token="+token)
+	if err := other.WriteJSON(&ServRequest{Type: WS_INIT, Payload: map[string]string{"resumeToken": "forged", "lastSeq": fmt.Sprint(3)}}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := readTestWS(t, other); msg["type"] != SESSION_RESUME || msg["payload"].(map[string]interface{})["resumed"] != "false" {
+		t.Errorf("expected a forged resume token to start a new session, got %v", msg)
+	}
+}
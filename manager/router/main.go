@@ -1,68 +1,181 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
 
 	"github.com/loisBN/zippytal-desktop/back/manager"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 )
 
-
 func main() {
-	lis,err := net.Listen("tcp",":8080")
+	configPath := flag.String("config", os.Getenv(manager.CONFIG_ENV_PREFIX+"CONFIG"), "path to the JSON configuration file")
+	configFlags := manager.NewConfigFlags(flag.CommandLine)
+	flag.Parse()
+	config, err := manager.LoadConfig(*configPath)
 	if err != nil {
 		log.Fatalln(err)
 	}
-	m,err := manager.NewManager()
+	if err = config.ApplyEnv(os.LookupEnv); err != nil {
+		log.Fatalln(err)
+	}
+	if err = configFlags.Apply(config); err != nil {
+		log.Fatalln(err)
+	}
+	if err = config.Validate(); err != nil {
+		log.Fatalln(err)
+	}
+	lis, err := net.Listen("tcp", config.Listeners.GRPC)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	m, err := manager.NewManagerFromConfig(config)
 	if err != nil {
 		log.Fatal(err)
 	}
-	h := manager.NewWSHandler(m,[]manager.WSMiddleware{manager.NewWSStateMiddleware()},[]manager.HTTPMiddleware{&manager.SquadHTTPMiddleware{}})
-		serv := manager.NewWSServ(":9999",h)
-		fmt.Println("server launch")
-		certFile := "/etc/letsencrypt/live/app.zippytal.com/fullchain.pem"
-		keyFile := "/etc/letsencrypt/live/app.zippytal.com/privkey.pem"
-		certFileW := "/etc/letsencrypt/live/zippytal.com/fullchain.pem"
-		keyFileW := "/etc/letsencrypt/live/zippytal.com/privkey.pem"
+	reaperCtx, stopReaper := context.WithCancel(context.Background())
+	go m.StartReaper(reaperCtx)
+	errCh := make(chan error, 3)
+	h := manager.NewWSHandler(m, config.Static.AppDir, []manager.WSMiddleware{manager.NewWSStateMiddleware()}, []manager.HTTPMiddleware{&manager.SquadHTTPMiddleware{}})
+	serv := manager.NewWSServ(config.Listeners.WS, h)
+	fmt.Println("server launch")
 	go func() {
-		log.Fatalln(serv.Server.ListenAndServeTLS(certFile,keyFile))
+		if config.TLS.Enabled {
+			errCh <- serv.Server.ListenAndServeTLS(config.TLS.App.CertFile, config.TLS.App.KeyFile)
+			return
+		}
+		errCh <- serv.Server.ListenAndServe()
 	}()
-	go func() {
-		tlsConfig := &tls.Config{}
-		tlsConfig.Certificates = make([]tls.Certificate, 2)
-		var err error
-		tlsConfig.Certificates[0], err = tls.LoadX509KeyPair(certFile,keyFile)
-		if err != nil {
-			log.Fatalln(err)
+	var s *http.Server
+	if config.Listeners.HTTPS != "" {
+		mux := http.NewServeMux()
+		mux.Handle(config.Static.AppHost+"/", h)
+		if config.Static.WebsiteHost != "" {
+			mux.HandleFunc(config.Static.WebsiteHost+"/", func(rw http.ResponseWriter, r *http.Request) {
+				if _, err := os.Stat(filepath.Join(config.Static.WebsiteDir, r.URL.Path)); os.IsNotExist(err) {
+					http.ServeFile(rw, r, filepath.Join(config.Static.WebsiteDir, "index.html"))
+				} else {
+					http.ServeFile(rw, r, filepath.Join(config.Static.WebsiteDir, r.URL.Path))
+				}
+			})
 		}
-		tlsConfig.Certificates[1], err = tls.LoadX509KeyPair(certFileW,keyFileW)
-		if err != nil {
-			log.Fatalln(err)
+		s = &http.Server{
+			Handler: mux,
 		}
-		http.Handle("app.zippytal.com/",h)
-		http.Handle("https://app.zippytal.com/",h)
-		http.HandleFunc("zippytal.com/",func(rw http.ResponseWriter, r *http.Request) {
-			if _,err :=  os.Stat("./website/"+r.URL.Path); os.IsNotExist(err) {
-				http.ServeFile(rw,r,"./website/index.html")
-			} else {
-				http.ServeFile(rw,r,"./website/" + r.URL.Path)
+		go func() {
+			if !config.TLS.Enabled {
+				lis, err := net.Listen("tcp", config.Listeners.HTTPS)
+				if err != nil {
+					errCh <- err
+					return
+				}
+				errCh <- s.Serve(lis)
+				return
 			}
-		})
-		s := &http.Server{
-			TLSConfig: tlsConfig,
+			tlsConfig := &tls.Config{}
+			for _, certificate := range []manager.CertificateConfig{config.TLS.App, config.TLS.Website} {
+				if certificate.CertFile == "" {
+					continue
+				}
+				cert, err := tls.LoadX509KeyPair(certificate.CertFile, certificate.KeyFile)
+				if err != nil {
+					errCh <- err
+					return
+				}
+				tlsConfig.Certificates = append(tlsConfig.Certificates, cert)
+			}
+			s.TLSConfig = tlsConfig
+			lis, err := tls.Listen("tcp", config.Listeners.HTTPS, tlsConfig)
+			if err != nil {
+				errCh <- err
+				return
+			}
+			errCh <- s.Serve(lis)
+		}()
+	}
+	serverOptions := []grpc.ServerOption{
+		grpc.MaxConcurrentStreams(config.GRPC.MaxConcurrentStreams),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    time.Duration(config.GRPC.KeepaliveTime),
+			Timeout: time.Duration(config.GRPC.KeepaliveTimeout),
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             time.Duration(config.GRPC.KeepaliveMinTime),
+			PermitWithoutStream: true,
+		}),
+		grpc.UnaryInterceptor(manager.NewAuthUnaryInterceptor(m.AuthManager)),
+		grpc.StreamInterceptor(manager.NewAuthStreamInterceptor(m.AuthManager)),
+	}
+	if config.GRPC.MaxRecvMsgSize > 0 {
+		serverOptions = append(serverOptions, grpc.MaxRecvMsgSize(config.GRPC.MaxRecvMsgSize))
+	}
+	if config.GRPC.MaxSendMsgSize > 0 {
+		serverOptions = append(serverOptions, grpc.MaxSendMsgSize(config.GRPC.MaxSendMsgSize))
+	}
+	grpcServer := grpc.NewServer(serverOptions...)
+	manager.RegisterGrpcManagerServer(grpcServer, manager.NewGRPCManagerService(m))
+	go func() {
+		errCh <- grpcServer.Serve(lis)
+	}()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt, syscall.SIGUSR1)
+	exitCode := 0
+	for running := true; running; {
+		select {
+		case sig := <-signals:
+			if sig == syscall.SIGUSR1 {
+				log.Println("draining : new sessions are refused")
+				m.Drain()
+				continue
+			}
+			log.Printf("received %s, shutting down\n", sig)
+			running = false
+		case err := <-errCh:
+			if errors.Is(err, http.ErrServerClosed) {
+				continue
+			}
+			log.Println(err)
+			exitCode, running = 1, false
 		}
-		lis,err := tls.Listen("tcp",":443",tlsConfig)
-		if err != nil {
-			log.Fatalln(err)
+	}
+	stopReaper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.Shutdown.Timeout))
+	if err := m.Shutdown(ctx); err != nil {
+		log.Println(err)
+	}
+	for _, server := range []*http.Server{serv.Server, s} {
+		if server == nil {
+			continue
 		}
-		log.Fatalln(s.Serve(lis))
+		if err := server.Shutdown(ctx); err != nil {
+			log.Println(err)
+		}
+	}
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
 	}()
-	grpcServer := grpc.NewServer(grpc.MaxConcurrentStreams(100000))
-	manager.RegisterGrpcManagerServer(grpcServer,manager.NewGRPCManagerService(m))
-	log.Fatalln(grpcServer.Serve(lis))
-}
\ No newline at end of file
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+	if err := m.Close(ctx); err != nil {
+		log.Println(err)
+	}
+	cancel()
+	os.Exit(exitCode)
+}
@@ -0,0 +1,80 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type SessionDBManager struct {
+	*mongo.Collection
+}
+
+const SESSION_COLLECTION_NAME = "sessions"
+
+func NewSessionDBManager(uri string, dbName string) (sessionDBManager *SessionDBManager, err error) {
+	sessionDBManagerCh, errCh := make(chan *SessionDBManager), make(chan error)
+	go func() {
+		dbManagerCh, errC := NewDbManager(context.Background(), dbName, uri)
+		select {
+		case dbManager := <-dbManagerCh:
+			sessionDBManagerCh <- &SessionDBManager{dbManager.Db.Collection(SESSION_COLLECTION_NAME)}
+		case e := <-errC:
+			errCh <- e
+		}
+	}()
+	select {
+	case err = <-errCh:
+		return
+	case sessionDBManager = <-sessionDBManagerCh:
+		return
+	}
+}
+
+func (sdm *SessionDBManager) AddSession(ctx context.Context, session *Session) (err error) {
+	_, err = sdm.InsertOne(ctx, session)
+	return
+}
+
+func (sdm *SessionDBManager) GetSession(ctx context.Context, token string) (session *Session, err error) {
+	err = sdm.FindOne(ctx, bson.M{"token": token}).Decode(&session)
+	return
+}
+
+func (sdm *SessionDBManager) GetSessions(ctx context.Context) (sessions []*Session, err error) {
+	res, err := sdm.Find(ctx, bson.M{})
+	if err != nil {
+		return
+	}
+	err = res.All(ctx, &sessions)
+	return
+}
+
+func (sdm *SessionDBManager) UpdateSessionExpiry(ctx context.Context, token string, lastSeen time.Time, expiresAt time.Time) (err error) {
+	_, err = sdm.UpdateOne(ctx, bson.M{"token": token}, bson.M{
+		"$set": bson.M{"lastseen": lastSeen, "expiresat": expiresAt},
+	})
+	return
+}
+
+func (sdm *SessionDBManager) DeleteSession(ctx context.Context, token string) (err error) {
+	_, err = sdm.DeleteOne(ctx, bson.M{"token": token})
+	return
+}
+
+func (sdm *SessionDBManager) DeletePeerSessions(ctx context.Context, peerId string) (err error) {
+	_, err = sdm.DeleteMany(ctx, bson.M{"peerid": peerId})
+	return
+}
+
+func (sdm *SessionDBManager) DeleteKeySessions(ctx context.Context, peerId string, keyId string) (err error) {
+	_, err = sdm.DeleteMany(ctx, bson.M{"peerid": peerId, "keyid": keyId})
+	return
+}
+
+func (sdm *SessionDBManager) DeleteExpiredSessions(ctx context.Context, now time.Time) (err error) {
+	_, err = sdm.DeleteMany(ctx, bson.M{"expiresat": bson.M{"$lte": now}})
+	return
+}
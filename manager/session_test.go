@@ -0,0 +1,135 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSessionExpiry(t *testing.T) {
+	now := time.Now()
+	am := NewAuthManager(time.Hour, nil)
+	am.now = func() time.Time { return now }
+	if _, err := am.IssueSession("lolo", "", "token"); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(50 * time.Minute)
+	if peerId, err := am.ValidateSession("token"); err != nil || peerId != "lolo" {
+		t.Fatalf("expected a valid session for lolo, got %s %v", peerId, err)
+	}
+	now = now.Add(50 * time.Minute)
+	if _, err := am.ValidateSession("token"); err != nil {
+		t.Fatalf("expected the session to be refreshed by the last use, got %v", err)
+	}
+	now = now.Add(time.Hour)
+	if _, err := am.ValidateSession("token"); err == nil {
+		t.Fatal("expected the session to be expired")
+	}
+}
+
+func TestSessionRevocation(t *testing.T) {
+	m := NewMemoryManager()
+	first, other := newTestSession(t, m, "lolo"), newTestSession(t, m, "lolo2")
+	session, err := m.AuthManager.IssueSession("lolo", "", "second_token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second := session.Token
+	if err = m.Logout(first); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.authenticate(first, "lolo"); err == nil {
+		t.Error("expected the logged out session to be rejected")
+	}
+	if err = m.RevokePeerSessions(other, "lolo"); err == nil {
+		t.Error("expected lolo2 to be unable to revoke the sessions of lolo")
+	}
+	if err = m.RevokePeerSessions(second, "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.authenticate(second, "lolo"); err == nil {
+		t.Error("expected every session of lolo to be revoked")
+	}
+	if err = m.authenticate(other, "lolo2"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSessionPersistence(t *testing.T) {
+	store := NewMemorySessionStore()
+	am := NewAuthManager(time.Hour, store)
+	if _, err := am.IssueSession("lolo", "", "token"); err != nil {
+		t.Fatal(err)
+	}
+	restarted := NewAuthManager(time.Hour, store)
+	if err := restarted.LoadSessions(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if peerId, err := restarted.ValidateSession("token"); err != nil || peerId != "lolo" {
+		t.Fatalf("expected the session to survive a restart, got %s %v", peerId, err)
+	}
+	if err := restarted.RevokePeerSessions("lolo"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewAuthManager(time.Hour, store).ValidateSession("token"); err == nil {
+		t.Error("expected the revocation to be persisted")
+	}
+}
+
+func newTestTokenSigner(t *testing.T, activeKeyId string, keys map[string][]byte) *TokenSigner {
+	signer, err := NewTokenSigner("zippytal_test", time.Minute, activeKeyId, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+func TestAccessTokenRotation(t *testing.T) {
+	oldKey, newKey := bytes.Repeat([]byte{1}, MIN_SIGNING_KEY_SIZE), bytes.Repeat([]byte{2}, MIN_SIGNING_KEY_SIZE)
+	oldSigner := newTestTokenSigner(t, "old", map[string][]byte{"old": oldKey})
+	token, _, err := oldSigner.Sign("lolo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotated := newTestTokenSigner(t, "new", map[string][]byte{"old": oldKey, "new": newKey})
+	if claims, err := rotated.Verify(token); err != nil || claims.Subject != "lolo" {
+		t.Fatalf("expected a token signed by a rotated key to verify, got %v %v", claims, err)
+	}
+	if _, err = newTestTokenSigner(t, "new", map[string][]byte{"new": newKey}).Verify(token); err == nil {
+		t.Error("expected a token signed by a retired key to be rejected")
+	}
+	parts := strings.Split(token, ".")
+	if _, err = rotated.Verify(parts[0] + "." + parts[1] + "x." + parts[2]); err == nil {
+		t.Error("expected a tampered token to be rejected")
+	}
+	now := time.Now()
+	rotated.now = func() time.Time { return now.Add(time.Hour) }
+	if _, err = rotated.Verify(token); err == nil {
+		t.Error("expected an expired token to be rejected")
+	}
+}
+
+func TestAccessTokenAcrossManagers(t *testing.T) {
+	key := bytes.Repeat([]byte{3}, MIN_SIGNING_KEY_SIZE)
+	issuer, other := NewMemoryManager(), NewMemoryManager()
+	issuer.AuthManager.TokenSigner = newTestTokenSigner(t, "k", map[string][]byte{"k": key})
+	other.AuthManager.TokenSigner = newTestTokenSigner(t, "k", map[string][]byte{"k": key})
+	_, accessToken, err := issuer.IssueAccessToken(newTestSession(t, issuer, "lolo"), "lolo", []string{"0xff"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = other.authenticateSquad(accessToken, "lolo", "0xff"); err != nil {
+		t.Errorf("expected the access token to verify on another manager, got %v", err)
+	}
+	if err = other.authenticateSquad(accessToken, "lolo", "0xfg"); err == nil {
+		t.Error("expected the squad scoped token to be rejected for another squad")
+	}
+	if err = other.authenticate(accessToken, "lolo2"); err == nil {
+		t.Error("expected the token to be rejected for another peer")
+	}
+	if _, _, err = issuer.IssueAccessToken(accessToken, "lolo", nil); err == nil {
+		t.Error("expected an access token to be unable to issue another one")
+	}
+}
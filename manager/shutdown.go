@@ -0,0 +1,104 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const SERVER_DRAINING string = "server_draining"
+
+const DEFAULT_SHUTDOWN_TIMEOUT = 30 * time.Second
+
+var ErrDraining = errors.New("server is draining, reconnect elsewhere")
+
+func (manager *Manager) setState(state ManagerState) {
+	manager.Lock()
+	defer manager.Unlock()
+	manager.State = state
+}
+
+func (manager *Manager) Accepting() bool {
+	manager.RLock()
+	defer manager.RUnlock()
+	return manager.State == ON
+}
+
+func (manager *Manager) stopped() bool {
+	manager.RLock()
+	defer manager.RUnlock()
+	return manager.State == OFF
+}
+
+func (manager *Manager) Drain() {
+	manager.setState(DRAINING)
+}
+
+func (manager *Manager) Shutdown(ctx context.Context) (err error) {
+	manager.setState(OFF)
+	peers := manager.Router.connections()
+	for _, peer := range peers {
+		if e := peer.conn.Send(&Envelope{
+			Type:    SERVER_DRAINING,
+			To:      peer.id,
+			Payload: map[string]string{"reason": "shutdown"},
+		}); e != nil {
+			log.Println(e)
+		}
+		if e := peer.conn.Close(); e != nil {
+			log.Println(e)
+		}
+	}
+	for _, peer := range peers {
+		select {
+		case <-peer.conn.Done():
+		case <-ctx.Done():
+			err = fmt.Errorf("outbound queues not flushed : %v", ctx.Err())
+			return
+		}
+	}
+	now := time.Now()
+	for _, peer := range peers {
+		manager.unregisterPeer(ctx, peer.id)
+		if e := manager.PeerStore.UpdatePeerPresence(ctx, peer.id, OFFLINE, now.Unix()); e != nil {
+			log.Println(e)
+		}
+		manager.hostLost(peer.id)
+		manager.ICE.stop(peer.id)
+	}
+	for _, expired := range manager.Resume.expireAll() {
+		manager.sessionExpired(expired.peerId, expired.pending)
+	}
+	return
+}
+
+func (manager *Manager) Close(ctx context.Context) (err error) {
+	if manager.Cluster != nil {
+		if manager.Cluster.unsubscribe != nil {
+			manager.Cluster.unsubscribe()
+		}
+		for _, closer := range []interface{}{manager.Cluster.Bus, manager.Cluster.Directory} {
+			if c, ok := closer.(interface{ Close() error }); ok {
+				if e := c.Close(); e != nil {
+					err = e
+				}
+			}
+		}
+	}
+	clients := make(map[*mongo.Client]bool)
+	for _, store := range []interface{}{manager.SquadStore, manager.HostedSquadStore, manager.PeerStore, manager.MessageStore, manager.AuthManager.SessionStore} {
+		s, ok := store.(interface{ Database() *mongo.Database })
+		if !ok || clients[s.Database().Client()] {
+			continue
+		}
+		clients[s.Database().Client()] = true
+		if e := s.Database().Client().Disconnect(ctx); e != nil {
+			err = e
+		}
+	}
+	return
+}
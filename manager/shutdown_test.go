@@ -0,0 +1,125 @@
+package manager
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestDrainAndShutdown(t *testing.T) {
+	m := NewMemoryManager()
+	url := newTestWSServer(t, m)
+	if err := m.PeerStore.AddNewPeer(context.Background(), &Peer{Id: "lolo", Name: "lolo"}); err != nil {
+		t.Fatal(err)
+	}
+	stream, cancel := linkTestPeer(t, m, "lolo")
+	defer cancel()
+	token := newTestSession(t, m, "lolo")
+	if err := m.CreateSquad(token, "0xff", "lolo", "test squad", PUBLIC, "", MESH, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.ConnectToSquad(token, "0xff", "lolo", "", "", MESH); err != nil {
+		t.Fatal(err)
+	}
+	conn := dialTestWS(t, url+"?token="+newTestSession(t, m, "lolo2"))
+	if err := conn.WriteJSON(&ServRequest{Type: WS_INIT}); err != nil {
+		t.Fatal(err)
+	}
+	waitForWSPeer(t, m, "lolo2")
+	m.Drain()
+	if _, _, err := websocket.DefaultDialer.Dial(url+"?token="+newTestSession(t, m, "lolo3"), nil); err == nil {
+		t.Error("expected new WebSocket sessions to be refused while draining")
+	}
+	if err := m.forward(&Envelope{Type: "offer", From: "lolo2", To: "lolo", Payload: map[string]string{}}); err != nil {
+		t.Errorf("expected existing sessions to keep working while draining, got %v", err)
+	}
+	ctx, cancelShutdown := context.WithTimeout(context.Background(), time.Second)
+	defer cancelShutdown()
+	if err := m.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{string(MESH_TOPOLOGY), "offer", SERVER_DRAINING} {
+		select {
+		case res := <-stream.sent:
+			if res.Type != expected {
+				t.Errorf("expected %s before the stream closed, got %v", expected, res)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("lolo never received %s", expected)
+		}
+	}
+	if msg := readTestWS(t, conn); msg["type"] != SERVER_DRAINING {
+		t.Errorf("expected lolo2 to be told to reconnect elsewhere, got %v", msg)
+	}
+	peer, err := m.PeerStore.GetPeer(context.Background(), "lolo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peer.Status != string(OFFLINE) || peer.LastSeen == 0 {
+		t.Errorf("expected lolo's presence to be saved, got %+v", peer)
+	}
+	if squad, err := m.SquadStore.GetSquad(context.Background(), "0xff"); err != nil || len(squad.Members) != 1 || squad.Members[0] != "lolo" {
+		t.Errorf("expected lolo to stay in its squads to rejoin from another node, got %v %v", squad, err)
+	}
+	if _, _, err := websocket.DefaultDialer.Dial(url+"?token="+newTestSession(t, m, "lolo3"), nil); err == nil {
+		t.Error("expected new WebSocket sessions to be refused after shutdown")
+	}
+}
+
+func TestShutdownFlushesLinkStreams(t *testing.T) {
+	m := NewMemoryManager()
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.StreamInterceptor(NewAuthStreamInterceptor(m.AuthManager)))
+	RegisterGrpcManagerServer(server, NewGRPCManagerService(m))
+	go server.Serve(lis)
+	defer server.Stop()
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	link, err := NewGrpcManagerClient(conn).Link(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = link.Send(&Request{Type: INIT, Token: newTestSession(t, m, "lolo")}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100 && !m.Router.Connected("lolo"); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	for i := 0; i < 10; i++ {
+		if err = m.forward(&Envelope{Type: "offer", From: "lolo2", To: "lolo", Payload: map[string]string{}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err = m.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	offers, last := 0, ""
+	for {
+		res, err := link.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("expected the stream to end cleanly, got %v", err)
+		}
+		if res.Type == "offer" {
+			offers++
+		}
+		last = res.Type
+	}
+	if offers != 10 || last != SERVER_DRAINING {
+		t.Errorf("expected the queued offers then %s over the Link stream, got %d offers and %s last", SERVER_DRAINING, offers, last)
+	}
+}
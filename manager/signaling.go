@@ -0,0 +1,247 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	SIGNAL_OFFER             string = "offer"
+	SIGNAL_ANSWER            string = "answer"
+	SIGNAL_CANDIDATE         string = "candidate"
+	SIGNAL_END_OF_CANDIDATES string = "end_of_candidates"
+	SIGNAL_RENEGOTIATE       string = "renegotiate"
+	SIGNAL_HANGUP            string = "hangup"
+)
+
+const (
+	SIGNAL_UNTYPED           = "untyped_message"
+	SIGNAL_RESERVED_TYPE     = "reserved_type"
+	SIGNAL_NO_RECIPIENT      = "no_recipient"
+	SIGNAL_INVALID_SDP       = "invalid_sdp"
+	SIGNAL_SDP_TOO_LARGE     = "sdp_too_large"
+	SIGNAL_INVALID_CANDIDATE = "invalid_candidate"
+	SIGNAL_NOT_ALLOWED       = "not_allowed"
+)
+
+const (
+	DEFAULT_MAX_SDP_SIZE       = 64 * 1024
+	DEFAULT_MAX_CANDIDATE_SIZE = 1024
+)
+
+var signalingTypes = map[string]bool{
+	SIGNAL_OFFER:             true,
+	SIGNAL_ANSWER:            true,
+	SIGNAL_CANDIDATE:         true,
+	SIGNAL_END_OF_CANDIDATES: true,
+	SIGNAL_RENEGOTIATE:       true,
+	SIGNAL_HANGUP:            true,
+}
+
+var candidateTypes = map[string]bool{
+	"host":  true,
+	"srflx": true,
+	"prflx": true,
+	"relay": true,
+}
+
+type SignalingError struct {
+	Code   string
+	Reason string
+}
+
+func (err *SignalingError) Error() string {
+	return err.Reason
+}
+
+func signalingError(code string, format string, a ...interface{}) error {
+	return &SignalingError{Code: code, Reason: fmt.Sprintf(format, a...)}
+}
+
+func validateSDP(sdp string, maxSize int) (err error) {
+	if len(sdp) > maxSize {
+		err = signalingError(SIGNAL_SDP_TOO_LARGE, "the sdp is %d bytes long, the limit is %d", len(sdp), maxSize)
+		return
+	}
+	if !strings.HasPrefix(sdp, "v=0") {
+		err = signalingError(SIGNAL_INVALID_SDP, "the sdp must start with v=0")
+	}
+	return
+}
+
+func validateCandidate(candidate string, maxSize int) (err error) {
+	if len(candidate) > maxSize {
+		err = signalingError(SIGNAL_INVALID_CANDIDATE, "the candidate is %d bytes long, the limit is %d", len(candidate), maxSize)
+		return
+	}
+	line := strings.TrimPrefix(candidate, "a=")
+	fields := strings.Fields(strings.TrimPrefix(line, "candidate:"))
+	switch {
+	case !strings.HasPrefix(line, "candidate:") || len(fields) < 8:
+		err = fmt.Errorf("expected candidate:foundation component transport priority address port typ type")
+	case len(fields[0]) > 32:
+		err = fmt.Errorf("the foundation %q is too long", fields[0])
+	case !validUint(fields[1], 1, 256):
+		err = fmt.Errorf("the component %q is not a number between 1 and 256", fields[1])
+	case !strings.EqualFold(fields[2], "udp") && !strings.EqualFold(fields[2], "tcp"):
+		err = fmt.Errorf("the transport %q is neither udp nor tcp", fields[2])
+	case !validUint(fields[3], 0, 1<<32-1):
+		err = fmt.Errorf("the priority %q is not a 32 bits number", fields[3])
+	case net.ParseIP(fields[4]) == nil && !strings.HasSuffix(fields[4], ".local"):
+		err = fmt.Errorf("the address %q is neither an ip nor an mdns name", fields[4])
+	case !validUint(fields[5], 0, 65535):
+		err = fmt.Errorf("the port %q is not valid", fields[5])
+	case fields[6] != "typ" || !candidateTypes[fields[7]]:
+		err = fmt.Errorf("the candidate type must be host, srflx, prflx or relay")
+	}
+	if err != nil {
+		err = signalingError(SIGNAL_INVALID_CANDIDATE, "malformed candidate : %v", err)
+	}
+	return
+}
+
+func validUint(s string, min uint64, max uint64) bool {
+	i, err := strconv.ParseUint(s, 10, 64)
+	return err == nil && i >= min && i <= max
+}
+
+func validateSignal(signalType string, signal *Signal, config SignalingConfig) (err error) {
+	switch signalType {
+	case SIGNAL_OFFER, SIGNAL_ANSWER:
+		err = validateSDP(signal.Sdp, config.MaxSDPSize)
+	case SIGNAL_CANDIDATE:
+		err = validateCandidate(signal.Candidate, config.MaxCandidateSize)
+	}
+	return
+}
+
+type signalGrant struct {
+	from    string
+	to      string
+	squadId string
+}
+
+type SignalGrants struct {
+	grants map[signalGrant]bool
+	*sync.RWMutex
+}
+
+func NewSignalGrants() *SignalGrants {
+	return &SignalGrants{
+		grants:  make(map[signalGrant]bool),
+		RWMutex: &sync.RWMutex{},
+	}
+}
+
+func (sg *SignalGrants) allowed(grant signalGrant) bool {
+	sg.RLock()
+	defer sg.RUnlock()
+	return sg.grants[grant]
+}
+
+func (sg *SignalGrants) grant(grant signalGrant) {
+	sg.Lock()
+	defer sg.Unlock()
+	sg.grants[grant] = true
+}
+
+func (sg *SignalGrants) revokePeer(peerId string) {
+	sg.Lock()
+	defer sg.Unlock()
+	for grant := range sg.grants {
+		if grant.from == peerId || grant.to == peerId {
+			delete(sg.grants, grant)
+		}
+	}
+}
+
+func (sg *SignalGrants) revokeSquad(squadId string) {
+	sg.Lock()
+	defer sg.Unlock()
+	for grant := range sg.grants {
+		if grant.squadId == squadId {
+			delete(sg.grants, grant)
+		}
+	}
+}
+
+func squadLinks(squad *Squad, peerId string, other string) bool {
+	return (containsPeer(squad.Members, peerId) || squad.HostId == peerId) && (containsPeer(squad.Members, other) || squad.HostId == other)
+}
+
+func (manager *Manager) canSignal(from string, to string, squadId string) (allowed bool) {
+	grant := signalGrant{from: from, to: to, squadId: squadId}
+	if manager.SignalGrants.allowed(grant) {
+		return true
+	}
+	if squadId != "" {
+		squad, _, err := manager.findSquad(squadId)
+		allowed = err == nil && squadLinks(squad, from, to)
+	} else {
+		peer, err := manager.PeerStore.GetPeer(context.Background(), from)
+		allowed = err == nil && containsPeer(peer.Friends, to)
+	}
+	if allowed {
+		manager.SignalGrants.grant(grant)
+	}
+	return
+}
+
+func (manager *Manager) checkSignal(envelope *Envelope) (err error) {
+	if isServerMessageType(envelope.Type) {
+		err = signalingError(SIGNAL_RESERVED_TYPE, "%s messages can only be sent by the server", envelope.Type)
+		return
+	}
+	if !signalingTypes[envelope.Type] || envelope.Signal == nil {
+		if envelope.Type != DIRECT_MESSAGE && !manager.Signaling.AllowLegacy {
+			err = signalingError(SIGNAL_UNTYPED, "untyped %s messages are not accepted, send a signal", envelope.Type)
+			return
+		}
+		if envelope.To != "" && !manager.canSignal(envelope.From, envelope.To, envelope.Payload["squadId"]) {
+			err = signalingError(SIGNAL_NOT_ALLOWED, "%s is neither your friend nor in the squad of the message", envelope.To)
+		}
+		return
+	}
+	if envelope.To == "" || envelope.To == envelope.From {
+		err = signalingError(SIGNAL_NO_RECIPIENT, "a %s needs another peer as recipient", envelope.Type)
+		return
+	}
+	if err = validateSignal(envelope.Type, envelope.Signal, manager.Signaling); err != nil {
+		return
+	}
+	if !manager.canSignal(envelope.From, envelope.To, envelope.Signal.SquadId) {
+		err = signalingError(SIGNAL_NOT_ALLOWED, "%s is neither your friend nor in the squad of the signal", envelope.To)
+	}
+	return
+}
+
+func (manager *Manager) relay(envelope *Envelope) {
+	if err := manager.checkSignal(envelope); err != nil {
+		manager.rejectSignal(envelope, err)
+		return
+	}
+	if err := manager.forward(envelope); err != nil {
+		log.Println(err)
+	}
+}
+
+func (manager *Manager) rejectSignal(envelope *Envelope, err error) {
+	payload := map[string]string{"type": envelope.Type, "to": envelope.To, "reason": err.Error()}
+	var signalingErr *SignalingError
+	if errors.As(err, &signalingErr) {
+		payload["code"] = signalingErr.Code
+	}
+	if err = manager.send(&Envelope{
+		Type:    WS_ERROR,
+		To:      envelope.From,
+		Payload: payload,
+	}); err != nil {
+		log.Println(err)
+	}
+}
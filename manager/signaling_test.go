@@ -0,0 +1,85 @@
+package manager
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSignaling(t *testing.T) {
+	m := NewMemoryManager()
+	tokens := map[string]string{}
+	streams := map[string]*testLinkStream{}
+	friends := map[string][]string{"lolo": {"lolo3"}, "lolo3": {"lolo"}}
+	for _, peer := range []string{"lolo", "lolo2", "lolo3", "lolo4"} {
+		if err := m.PeerStore.AddNewPeer(context.Background(), &Peer{Id: peer, Name: peer, Friends: friends[peer]}); err != nil {
+			t.Fatal(err)
+		}
+		tokens[peer] = newTestSession(t, m, peer)
+		stream, cancel := linkTestPeer(t, m, peer)
+		defer cancel()
+		streams[peer] = stream
+	}
+	if err := m.CreateSquad(tokens["lolo"], "0xff", "lolo", "test squad", PUBLIC, "", MESH, ""); err != nil {
+		t.Fatal(err)
+	}
+	for _, peer := range []string{"lolo", "lolo2"} {
+		if err := m.ConnectToSquad(tokens[peer], "0xff", peer, "", "", MESH); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m.relay(&Envelope{Type: SIGNAL_OFFER, From: "lolo", To: "lolo2", Signal: &Signal{SquadId: "0xff", Sdp: "v=0\r\no=- 0 0 IN IP4 127.0.0.1\r\n"}})
+	if res := nextEvent(t, streams["lolo2"], SIGNAL_OFFER); res.Signal == nil || !strings.HasPrefix(res.Signal.Sdp, "v=0") || res.Payload["from"] != "lolo" {
+		t.Errorf("expected lolo2 to receive the offer of lolo, got %v", res)
+	}
+	m.relay(&Envelope{Type: SIGNAL_CANDIDATE, From: "lolo2", To: "lolo", Signal: &Signal{SquadId: "0xff", Candidate: "candidate:842163049 1 udp 1677729535 203.0.113.7 46154 typ srflx raddr 0.0.0.0 rport 0", SdpMid: "0"}})
+	if res := nextEvent(t, streams["lolo"], SIGNAL_CANDIDATE); res.Signal == nil || res.Signal.SdpMid != "0" {
+		t.Errorf("expected lolo to receive the candidate of lolo2, got %v", res)
+	}
+	for _, c := range []struct {
+		envelope *Envelope
+		code     string
+	}{
+		{&Envelope{Type: SIGNAL_CANDIDATE, From: "lolo", To: "lolo2", Signal: &Signal{Candidate: "candidate:1 1 udp 2122260223 not-an-ip 54400 typ host"}}, SIGNAL_INVALID_CANDIDATE},
+		{&Envelope{Type: SIGNAL_ANSWER, From: "lolo", To: "lolo2", Signal: &Signal{Sdp: "o=- 0 0 IN IP4 127.0.0.1"}}, SIGNAL_INVALID_SDP},
+		{&Envelope{Type: SIGNAL_OFFER, From: "lolo", To: "lolo2", Signal: &Signal{Sdp: "v=0" + strings.Repeat("a", DEFAULT_MAX_SDP_SIZE)}}, SIGNAL_SDP_TOO_LARGE},
+		{&Envelope{Type: SIGNAL_HANGUP, From: "lolo", To: "lolo4", Signal: &Signal{}}, SIGNAL_NOT_ALLOWED},
+		{&Envelope{Type: SIGNAL_RENEGOTIATE, From: "lolo", To: "lolo2", Signal: &Signal{SquadId: "0xfe"}}, SIGNAL_NOT_ALLOWED},
+		{&Envelope{Type: SIGNAL_RENEGOTIATE, From: "lolo", To: "lolo2", Signal: &Signal{}}, SIGNAL_NOT_ALLOWED},
+		{&Envelope{Type: SIGNAL_END_OF_CANDIDATES, From: "lolo", Signal: &Signal{}}, SIGNAL_NO_RECIPIENT},
+	} {
+		m.relay(c.envelope)
+		if res := nextEvent(t, streams["lolo"], WS_ERROR); res.Payload["code"] != c.code || res.Payload["type"] != c.envelope.Type {
+			t.Errorf("expected the %s to be rejected with %s, got %v", c.envelope.Type, c.code, res.Payload)
+		}
+	}
+	if err := m.LeaveSquad(tokens["lolo2"], "0xff", "lolo2", MESH); err != nil {
+		t.Fatal(err)
+	}
+	m.relay(&Envelope{Type: SIGNAL_HANGUP, From: "lolo", To: "lolo2", Signal: &Signal{SquadId: "0xff"}})
+	if res := nextEvent(t, streams["lolo"], WS_ERROR); res.Payload["code"] != SIGNAL_NOT_ALLOWED {
+		t.Errorf("expected signals to a peer who left the squad to be rejected, got %v", res.Payload)
+	}
+	m.relay(&Envelope{Type: SIGNAL_OFFER, From: "lolo", To: "lolo3", Payload: map[string]string{"sdp": "legacy"}})
+	if res := nextEvent(t, streams["lolo3"], SIGNAL_OFFER); res.Payload["sdp"] != "legacy" {
+		t.Errorf("expected legacy signaling between friends to pass through, got %v", res)
+	}
+	for _, envelope := range []*Envelope{
+		{Type: SIGNAL_OFFER, From: "lolo4", To: "lolo", Payload: map[string]string{"sdp": "legacy"}},
+		{Type: DIRECT_MESSAGE, From: "lolo4", To: "lolo", Payload: map[string]string{"text": "hi"}},
+	} {
+		m.relay(envelope)
+		if res := nextEvent(t, streams["lolo4"], WS_ERROR); res.Payload["code"] != SIGNAL_NOT_ALLOWED || res.Payload["type"] != envelope.Type {
+			t.Errorf("expected an untyped %s between strangers to be rejected, got %v", envelope.Type, res.Payload)
+		}
+	}
+	m.Signaling.AllowLegacy = false
+	m.relay(&Envelope{Type: SIGNAL_OFFER, From: "lolo", To: "lolo3", Payload: map[string]string{"sdp": "legacy"}})
+	if res := nextEvent(t, streams["lolo"], WS_ERROR); res.Payload["code"] != SIGNAL_UNTYPED {
+		t.Errorf("expected legacy signaling to be rejected once disabled, got %v", res.Payload)
+	}
+	m.relay(&Envelope{Type: DIRECT_MESSAGE, From: "lolo", To: "lolo3", Payload: map[string]string{"text": "hi"}})
+	if res := nextEvent(t, streams["lolo3"], DIRECT_MESSAGE); res.Payload["text"] != "hi" {
+		t.Errorf("expected direct messages to pass without legacy signaling, got %v", res)
+	}
+}
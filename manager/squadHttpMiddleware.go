@@ -1,9 +1,13 @@
 package manager
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 )
 
 const (
@@ -29,6 +33,40 @@ const (
 	PEER_AUTH_INIT                  = "peer_auth_init"
 	PEER_AUTH_VERIFY                = "peer_auth_verify"
 	CREATE_PEER                     = "create_peer"
+	LOGOUT                          = "logout"
+	REVOKE_SESSIONS                 = "revoke_sessions"
+	ISSUE_ACCESS_TOKEN              = "issue_access_token"
+	ADD_PEER_KEY                    = "add_peer_key"
+	LIST_PEER_KEYS                  = "list_peer_keys"
+	REVOKE_PEER_KEY                 = "revoke_peer_key"
+	KICK_SQUAD_MEMBER               = "kick_squad_member"
+	BAN_SQUAD_MEMBER                = "ban_squad_member"
+	UNBAN_SQUAD_MEMBER              = "unban_squad_member"
+	SET_SQUAD_ROLE                  = "set_squad_role"
+	TRANSFER_SQUAD_OWNERSHIP        = "transfer_squad_ownership"
+	CREATE_SQUAD_INVITE             = "create_squad_invite"
+	LIST_SQUAD_INVITES              = "list_squad_invites"
+	REVOKE_SQUAD_INVITE             = "revoke_squad_invite"
+	JOIN_SQUAD_BY_INVITE            = "join_squad_by_invite"
+	REQUEST_JOIN_SQUAD              = "request_join_squad"
+	LIST_JOIN_REQUESTS              = "list_join_requests"
+	ANSWER_JOIN_REQUEST             = "answer_join_request"
+	SET_PRESENCE                    = "set_presence"
+	SUBSCRIBE_PRESENCE              = "subscribe_presence"
+	UNSUBSCRIBE_PRESENCE            = "unsubscribe_presence"
+	SEND_FRIEND_REQUEST             = "send_friend_request"
+	ACCEPT_FRIEND_REQUEST           = "accept_friend_request"
+	DECLINE_FRIEND_REQUEST          = "decline_friend_request"
+	CANCEL_FRIEND_REQUEST           = "cancel_friend_request"
+	REMOVE_FRIEND                   = "remove_friend"
+	BLOCK_PEER                      = "block_peer"
+	UNBLOCK_PEER                    = "unblock_peer"
+	LIST_FRIENDS                    = "list_friends"
+	REGISTER_HOST                   = "register_host"
+	UNREGISTER_HOST                 = "unregister_host"
+	LIST_HOSTS                      = "list_hosts"
+	SET_SQUAD_MESH_LIMIT            = "set_squad_mesh_limit"
+	GET_ICE_SERVERS                 = "get_ice_servers"
 )
 
 type SquadHTTPMiddleware struct{}
@@ -140,43 +178,122 @@ func (shm *SquadHTTPMiddleware) Process(r *ServRequest, req *http.Request, w htt
 			http.Error(w, "no field peerId in payload", http.StatusBadRequest)
 			return
 		}
-		token, err := m.PeerAuthInit(r.Payload["peerId"])
+		token, authType, err := m.PeerAuthInit(r.Payload["peerId"], r.Payload["keyId"])
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return err
 		}
 		err = json.NewEncoder(w).Encode(map[string]interface{}{
-			"success": true,
-			"peerId":  r.Payload["peerId"],
-			"token":   token,
+			"success":  true,
+			"peerId":   r.Payload["peerId"],
+			"token":    token,
+			"authType": authType,
 		})
 	case PEER_AUTH_VERIFY:
 		if _, ok := r.Payload["peerId"]; !ok {
 			http.Error(w, "no field peerId in payload", http.StatusBadRequest)
 			return
 		}
-		if _, ok := r.Payload["token"]; !ok {
-			http.Error(w, "no field peerKey in payload", http.StatusBadRequest)
+		response := []byte(r.Payload["token"])
+		if signature, ok := r.Payload["signature"]; ok {
+			if response, err = base64.StdEncoding.DecodeString(signature); err != nil {
+				http.Error(w, "field signature is not valid base64", http.StatusBadRequest)
+				return
+			}
+		} else if _, ok := r.Payload["token"]; !ok {
+			http.Error(w, "no field token or signature in payload", http.StatusBadRequest)
 			return
 		}
-		if err = m.PeerAuthVerif(r.Payload["peerId"], []byte(r.Payload["token"])); err != nil {
+		session, accessToken, err := m.PeerAuthVerif(r.Payload["peerId"], r.Payload["keyId"], response)
+		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success":     true,
+			"peerId":      r.Payload["peerId"],
+			"token":       session.Token,
+			"keyId":       session.KeyId,
+			"expiresAt":   session.ExpiresAt.Unix(),
+			"accessToken": accessToken,
+		})
+	case ISSUE_ACCESS_TOKEN:
+		squads := []string{}
+		if r.Payload["squads"] != "" {
+			squads = strings.Split(r.Payload["squads"], ",")
+		}
+		claims, accessToken, err := m.IssueAccessToken(r.Token, r.From, squads)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success":     true,
+			"peerId":      r.From,
+			"accessToken": accessToken,
+			"expiresAt":   claims.ExpiresAt,
+			"squads":      claims.Squads,
+		})
+	case LOGOUT:
+		if err = m.Logout(r.Token); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
 			return
 		}
 		err = json.NewEncoder(w).Encode(map[string]interface{}{
 			"success": true,
-			"peerId":  r.Payload["peerId"],
-			"token":   r.Payload["token"],
+		})
+	case REVOKE_SESSIONS:
+		if err = m.RevokePeerSessions(r.Token, r.From); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"peerId":  r.From,
+		})
+	case ADD_PEER_KEY:
+		if _, ok := r.Payload["peerKey"]; !ok {
+			http.Error(w, "no field peerKey in payload", http.StatusBadRequest)
+			return
+		}
+		key, err := m.AddPeerKey(r.Token, r.From, r.Payload["label"], r.Payload["peerKey"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"peerId":  r.From,
+			"key":     key,
+		})
+	case LIST_PEER_KEYS:
+		keys, err := m.ListPeerKeys(r.Token, r.From)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"peerId":  r.From,
+			"keys":    keys,
+		})
+	case REVOKE_PEER_KEY:
+		if _, ok := r.Payload["keyId"]; !ok {
+			http.Error(w, "no field keyId in payload", http.StatusBadRequest)
+			return
+		}
+		if err = m.RevokePeerKey(r.Token, r.From, r.Payload["keyId"]); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"peerId":  r.From,
+			"keyId":   r.Payload["keyId"],
 		})
 	case LIST_PEER:
 		peers := []*Peer{}
-		for id := range m.GRPCPeers {
-			peers = append(peers, &Peer{
-				Id:   id,
-				Name: "unknow",
-			})
-		}
-		for id := range m.WSPeers {
+		for _, id := range m.Router.PeerIds() {
 			peers = append(peers, &Peer{
 				Id:   id,
 				Name: "unknow",
@@ -197,7 +314,7 @@ func (shm *SquadHTTPMiddleware) Process(r *ServRequest, req *http.Request, w htt
 			http.Error(w, "no field networkType in payload", http.StatusBadRequest)
 			return
 		}
-		if err = m.ConnectToSquad(r.Token, r.Payload["squadId"], r.From, r.Payload["password"], r.Payload["networkType"]); err != nil {
+		if err = m.ConnectToSquad(r.Token, r.Payload["squadId"], r.From, r.Payload["password"], r.Payload["inviteCode"], r.Payload["networkType"]); err != nil {
 			http.Error(w, err.Error(), http.StatusForbidden)
 			return
 		}
@@ -282,7 +399,7 @@ func (shm *SquadHTTPMiddleware) Process(r *ServRequest, req *http.Request, w htt
 			http.Error(w, "no field squadNetworkType in payload", http.StatusBadRequest)
 			return
 		}
-		if err = m.LeaveSquad(r.Payload["squadId"], r.From, r.Payload["squadNetworkType"]); err != nil {
+		if err = m.LeaveSquad(r.Token, r.Payload["squadId"], r.From, r.Payload["squadNetworkType"]); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -312,18 +429,17 @@ func (shm *SquadHTTPMiddleware) Process(r *ServRequest, req *http.Request, w htt
 			http.Error(w, "no field squadNetworkType in payload", http.StatusBadRequest)
 			return
 		}
-		if r.Payload["squadNetworkType"] == HOSTED {
-			if _, ok := r.Payload["squadHost"]; !ok {
-				http.Error(w, "no field squadHost in payload", http.StatusBadRequest)
-				return
-			}
-		}
-		if err = m.CreateSquad(r.Token, r.Payload["squadId"], r.From, r.Payload["squadName"], SquadType(r.Payload["squadType"]), r.Payload["password"], r.Payload["squadNetworkType"], r.Payload["squadHost"]); err != nil {
+		host, err := m.createSquad(r.Token, r.Payload["squadId"], r.From, r.Payload["squadName"], SquadType(r.Payload["squadType"]), r.Payload["password"], r.Payload["squadNetworkType"], r.Payload["squadHost"], r.Payload["squadRegion"])
+		if errors.Is(err, ErrNoHost) {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return err
+		} else if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
+			return err
 		}
 		err = json.NewEncoder(w).Encode(map[string]interface{}{
-			"success": true,
+			"success":   true,
+			"squadHost": host,
 		})
 	case DELETE_SQUAD:
 		if _, ok := r.Payload["squadId"]; !ok {
@@ -370,7 +486,7 @@ func (shm *SquadHTTPMiddleware) Process(r *ServRequest, req *http.Request, w htt
 			http.Error(w, "no field squadName in payload", http.StatusBadRequest)
 			return
 		}
-		if err = m.UpdateSquadName(r.Payload["squadId"],r.Payload["squadName"]); err != nil {
+		if err = m.UpdateSquadName(r.Token, r.Payload["squadId"], r.From, r.Payload["squadName"]); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -386,7 +502,7 @@ func (shm *SquadHTTPMiddleware) Process(r *ServRequest, req *http.Request, w htt
 			http.Error(w, "no field password in payload", http.StatusBadRequest)
 			return
 		}
-		if err = m.UpdateSquadPassword(r.Payload["squadId"],r.Payload["password"]); err != nil {
+		if err = m.UpdateSquadPassword(r.Token, r.Payload["squadId"], r.From, r.Payload["password"]); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -402,13 +518,341 @@ func (shm *SquadHTTPMiddleware) Process(r *ServRequest, req *http.Request, w htt
 			http.Error(w, "no field authorizedMember in payload", http.StatusBadRequest)
 			return
 		}
-		if err = m.UpdateSquadAuthorizedMembers(r.Payload["squadId"],r.Payload["authorizedMember"]); err != nil {
+		if err = m.UpdateSquadAuthorizedMembers(r.Token, r.Payload["squadId"], r.From, r.Payload["authorizedMember"]); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 		err = json.NewEncoder(w).Encode(map[string]interface{}{
 			"success": true,
 		})
+	case KICK_SQUAD_MEMBER, BAN_SQUAD_MEMBER, UNBAN_SQUAD_MEMBER, SET_SQUAD_ROLE, TRANSFER_SQUAD_OWNERSHIP:
+		if _, ok := r.Payload["squadId"]; !ok {
+			http.Error(w, "no field squadId in payload", http.StatusBadRequest)
+			return
+		}
+		if _, ok := r.Payload["peerId"]; !ok {
+			http.Error(w, "no field peerId in payload", http.StatusBadRequest)
+			return
+		}
+		switch r.Type {
+		case KICK_SQUAD_MEMBER:
+			err = m.KickFromSquad(r.Token, r.Payload["squadId"], r.From, r.Payload["peerId"])
+		case BAN_SQUAD_MEMBER:
+			err = m.BanFromSquad(r.Token, r.Payload["squadId"], r.From, r.Payload["peerId"])
+		case UNBAN_SQUAD_MEMBER:
+			err = m.UnbanFromSquad(r.Token, r.Payload["squadId"], r.From, r.Payload["peerId"])
+		case SET_SQUAD_ROLE:
+			err = m.SetSquadRole(r.Token, r.Payload["squadId"], r.From, r.Payload["peerId"], SquadRole(r.Payload["role"]))
+		case TRANSFER_SQUAD_OWNERSHIP:
+			err = m.TransferSquadOwnership(r.Token, r.Payload["squadId"], r.From, r.Payload["peerId"])
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"squadId": r.Payload["squadId"],
+			"peerId":  r.Payload["peerId"],
+		})
+	case CREATE_SQUAD_INVITE:
+		if _, ok := r.Payload["squadId"]; !ok {
+			http.Error(w, "no field squadId in payload", http.StatusBadRequest)
+			return
+		}
+		var ttl time.Duration
+		if r.Payload["ttl"] != "" {
+			if ttl, err = time.ParseDuration(r.Payload["ttl"]); err != nil {
+				http.Error(w, "field ttl is not a valid duration", http.StatusBadRequest)
+				return
+			}
+		}
+		maxUses := 0
+		if r.Payload["maxUses"] != "" {
+			if maxUses, err = strconv.Atoi(r.Payload["maxUses"]); err != nil {
+				http.Error(w, "field maxUses is not a valid number", http.StatusBadRequest)
+				return
+			}
+		}
+		invite, err := m.CreateSquadInvite(r.Token, r.Payload["squadId"], r.From, ttl, maxUses, r.Payload["targetPeer"], SquadRole(r.Payload["role"]))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"invite":  invite,
+		})
+	case LIST_SQUAD_INVITES:
+		if _, ok := r.Payload["squadId"]; !ok {
+			http.Error(w, "no field squadId in payload", http.StatusBadRequest)
+			return
+		}
+		invites, err := m.ListSquadInvites(r.Token, r.Payload["squadId"], r.From)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"invites": invites,
+		})
+	case REVOKE_SQUAD_INVITE:
+		if _, ok := r.Payload["squadId"]; !ok {
+			http.Error(w, "no field squadId in payload", http.StatusBadRequest)
+			return
+		}
+		if _, ok := r.Payload["code"]; !ok {
+			http.Error(w, "no field code in payload", http.StatusBadRequest)
+			return
+		}
+		if err = m.RevokeSquadInvite(r.Token, r.Payload["squadId"], r.From, r.Payload["code"]); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"code":    r.Payload["code"],
+		})
+	case JOIN_SQUAD_BY_INVITE:
+		if _, ok := r.Payload["code"]; !ok {
+			http.Error(w, "no field code in payload", http.StatusBadRequest)
+			return
+		}
+		squadId, err := m.JoinSquadByInvite(r.Token, r.From, r.Payload["code"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"squadId": squadId,
+		})
+	case REQUEST_JOIN_SQUAD:
+		if _, ok := r.Payload["squadId"]; !ok {
+			http.Error(w, "no field squadId in payload", http.StatusBadRequest)
+			return
+		}
+		request, err := m.RequestToJoinSquad(r.Token, r.Payload["squadId"], r.From, r.Payload["message"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"request": request,
+		})
+	case LIST_JOIN_REQUESTS:
+		if _, ok := r.Payload["squadId"]; !ok {
+			http.Error(w, "no field squadId in payload", http.StatusBadRequest)
+			return
+		}
+		requests, err := m.ListSquadJoinRequests(r.Token, r.Payload["squadId"], r.From)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success":  true,
+			"requests": requests,
+		})
+	case ANSWER_JOIN_REQUEST:
+		if _, ok := r.Payload["squadId"]; !ok {
+			http.Error(w, "no field squadId in payload", http.StatusBadRequest)
+			return
+		}
+		if _, ok := r.Payload["requestId"]; !ok {
+			http.Error(w, "no field requestId in payload", http.StatusBadRequest)
+			return
+		}
+		approve, err := strconv.ParseBool(r.Payload["approve"])
+		if err != nil {
+			http.Error(w, "field approve must be true or false", http.StatusBadRequest)
+			return err
+		}
+		if err = m.AnswerSquadJoinRequest(r.Token, r.Payload["squadId"], r.From, r.Payload["requestId"], approve); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success":   true,
+			"requestId": r.Payload["requestId"],
+			"approved":  approve,
+		})
+	case SET_PRESENCE:
+		if _, ok := r.Payload["status"]; !ok {
+			http.Error(w, "no field status in payload", http.StatusBadRequest)
+			return
+		}
+		presence, err := m.SetPresence(r.Token, r.From, PresenceStatus(r.Payload["status"]))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success":  true,
+			"presence": presence,
+		})
+	case SUBSCRIBE_PRESENCE, UNSUBSCRIBE_PRESENCE:
+		if _, ok := r.Payload["peers"]; !ok {
+			http.Error(w, "no field peers in payload", http.StatusBadRequest)
+			return
+		}
+		peers := strings.Split(r.Payload["peers"], ",")
+		var presences []*PeerPresence
+		if r.Type == SUBSCRIBE_PRESENCE {
+			presences, err = m.SubscribePresence(r.Token, r.From, peers)
+		} else {
+			err = m.UnsubscribePresence(r.Token, r.From, peers)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success":   true,
+			"presences": presences,
+		})
+	case SEND_FRIEND_REQUEST:
+		if _, ok := r.Payload["peerId"]; !ok {
+			http.Error(w, "no field peerId in payload", http.StatusBadRequest)
+			return
+		}
+		request, err := m.SendFriendRequest(r.Token, r.From, r.Payload["peerId"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"request": request,
+		})
+	case ACCEPT_FRIEND_REQUEST, DECLINE_FRIEND_REQUEST, CANCEL_FRIEND_REQUEST:
+		if _, ok := r.Payload["requestId"]; !ok {
+			http.Error(w, "no field requestId in payload", http.StatusBadRequest)
+			return
+		}
+		answer := map[string]func(string, string, string) (*FriendRequest, error){
+			ACCEPT_FRIEND_REQUEST:  m.AcceptFriendRequest,
+			DECLINE_FRIEND_REQUEST: m.DeclineFriendRequest,
+			CANCEL_FRIEND_REQUEST:  m.CancelFriendRequest,
+		}[r.Type]
+		request, err := answer(r.Token, r.From, r.Payload["requestId"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"request": request,
+		})
+	case REMOVE_FRIEND, BLOCK_PEER, UNBLOCK_PEER:
+		if _, ok := r.Payload["peerId"]; !ok {
+			http.Error(w, "no field peerId in payload", http.StatusBadRequest)
+			return
+		}
+		apply := map[string]func(string, string, string) error{
+			REMOVE_FRIEND: m.RemoveFriend,
+			BLOCK_PEER:    m.BlockPeer,
+			UNBLOCK_PEER:  m.UnblockPeer,
+		}[r.Type]
+		if err = apply(r.Token, r.From, r.Payload["peerId"]); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"peerId":  r.Payload["peerId"],
+		})
+	case LIST_FRIENDS:
+		friends, blocked, requests, err := m.ListFriends(r.Token, r.From)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success":  true,
+			"friends":  friends,
+			"blocked":  blocked,
+			"requests": requests,
+		})
+	case SET_SQUAD_MESH_LIMIT:
+		if _, ok := r.Payload["squadId"]; !ok {
+			http.Error(w, "no field squadId in payload", http.StatusBadRequest)
+			return
+		}
+		limit, err := strconv.Atoi(r.Payload["limit"])
+		if err != nil {
+			http.Error(w, "no valid field limit in payload", http.StatusBadRequest)
+			return err
+		}
+		if err = m.SetSquadMeshLimit(r.Token, r.Payload["squadId"], r.From, limit); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"squadId": r.Payload["squadId"],
+			"limit":   limit,
+		})
+	case REGISTER_HOST:
+		if _, ok := r.Payload["capacity"]; !ok {
+			http.Error(w, "no field capacity in payload", http.StatusBadRequest)
+			return
+		}
+		capacity, err := strconv.Atoi(r.Payload["capacity"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return err
+		}
+		load := 0
+		if _, ok := r.Payload["load"]; ok {
+			if load, err = strconv.Atoi(r.Payload["load"]); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return err
+			}
+		}
+		host, err := m.RegisterHost(r.Token, r.From, r.Payload["region"], int32(capacity), int32(load))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"host":    host,
+		})
+	case UNREGISTER_HOST:
+		if err = m.UnregisterHost(r.Token, r.From); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+		})
+	case LIST_HOSTS:
+		hosts, err := m.ListHosts(r.Token, r.From, r.Payload["region"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"hosts":   hosts,
+		})
+	case GET_ICE_SERVERS:
+		servers, expiresAt, err := m.GetICEServers(r.Token, r.From, r.Payload["region"])
+		if errors.Is(err, ErrNoICEServers) {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return err
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return err
+		}
+		err = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success":    true,
+			"iceServers": servers,
+			"expiresAt":  expiresAt.Unix(),
+		})
 	}
 	return
 }
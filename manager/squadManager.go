@@ -25,6 +25,9 @@ type Squad struct {
 	Status      bool
 	AuthType
 	AuthorizedMembers []string
+	Roles             SquadRoles
+	MeshLimit         int
+	Migrated          bool
 	mutex             *sync.RWMutex
 }
 
@@ -37,7 +40,9 @@ func (squad *Squad) GetMembersLen() int {
 func (squad *Squad) Join(userId string) {
 	squad.mutex.Lock()
 	defer squad.mutex.Unlock()
-	squad.Members = append(squad.Members, userId)
+	if !containsPeer(squad.Members, userId) {
+		squad.Members = append(squad.Members, userId)
+	}
 }
 
 func (squad *Squad) Authenticate(password string) bool {
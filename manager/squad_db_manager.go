@@ -3,6 +3,7 @@ package manager
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -16,10 +17,15 @@ type SquadDBManager struct {
 
 const SQUAD_COLLECTION_NAME = "squads"
 
-func NewSquadDBManager(host string, port int) (squadDBManager *SquadDBManager, err error) {
+const (
+	SQUAD_INVITE_COLLECTION_SUFFIX       = "_invites"
+	SQUAD_JOIN_REQUEST_COLLECTION_SUFFIX = "_join_requests"
+)
+
+func NewSquadDBManager(uri string, dbName string) (squadDBManager *SquadDBManager, err error) {
 	squadDBManagerCh, errCh := make(chan *SquadDBManager), make(chan error)
 	go func() {
-		dbManagerCh, errC := NewDbManager(context.Background(), DB_NAME, host, port)
+		dbManagerCh, errC := NewDbManager(context.Background(), dbName, uri)
 		select {
 		case dbManager := <-dbManagerCh:
 			squadDBManagerCh <- &SquadDBManager{dbManager.Db.Collection(SQUAD_COLLECTION_NAME)}
@@ -45,9 +51,11 @@ func (pdm *SquadDBManager) AddNewSquad(ctx context.Context, squad *Squad) (err e
 	return
 }
 
-func (pdm *SquadDBManager) GetSquad(ctx context.Context, peerId string) (squad *Squad, err error) {
+func (pdm *SquadDBManager) GetSquad(ctx context.Context, squadId string) (squad *Squad, err error) {
 	var s Squad
-	err = pdm.FindOne(ctx, bson.M{"id": peerId}).Decode(&s)
+	if err = pdm.FindOne(ctx, bson.M{"id": squadId}).Decode(&s); err != nil {
+		return
+	}
 	squad = &s
 	return
 }
@@ -62,7 +70,7 @@ func (pdm *SquadDBManager) GetSquads(ctx context.Context, limit int64, lastIndex
 }
 
 func (pdm *SquadDBManager) GetSquadsByName(ctx context.Context, pattern string, limit int64, lastIndex int64) (squads []*Squad, err error) {
-	res, err := pdm.Find(ctx, bson.D{{"name", primitive.Regex{Pattern: pattern, Options: ""}}}, options.Find().SetLimit(limit).SetSkip(lastIndex))
+	res, err := pdm.Find(ctx, bson.D{{Key: "name", Value: primitive.Regex{Pattern: pattern, Options: ""}}}, options.Find().SetLimit(limit).SetSkip(lastIndex))
 	if err != nil {
 		return
 	}
@@ -71,7 +79,7 @@ func (pdm *SquadDBManager) GetSquadsByName(ctx context.Context, pattern string,
 }
 
 func (pdm *SquadDBManager) GetSquadsByID(ctx context.Context, pattern string, limit int64, lastIndex int64) (squads []*Squad, err error) {
-	res, err := pdm.Find(ctx, bson.D{{"id", primitive.Regex{Pattern: pattern, Options: ""}}}, options.Find().SetLimit(limit).SetSkip(lastIndex))
+	res, err := pdm.Find(ctx, bson.D{{Key: "id", Value: primitive.Regex{Pattern: pattern, Options: ""}}}, options.Find().SetLimit(limit).SetSkip(lastIndex))
 	if err != nil {
 		return
 	}
@@ -88,35 +96,186 @@ func (pdm *SquadDBManager) GetSquadsByOwner(ctx context.Context, owner string, l
 	return
 }
 
+func (pdm *SquadDBManager) GetSquadsByHost(ctx context.Context, host string, limit int64, lastIndex int64) (squads []*Squad, err error) {
+	res, err := pdm.Find(ctx, bson.M{"hostid": host}, options.Find().SetLimit(limit).SetSkip(lastIndex))
+	if err != nil {
+		return
+	}
+	err = res.All(ctx, &squads)
+	return
+}
+
 func (pdm *SquadDBManager) DeleteSquad(ctx context.Context, squadId string) (err error) {
 	_, err = pdm.DeleteOne(ctx, bson.M{"id": squadId})
 	return
 }
 
 func (pdm *SquadDBManager) UpdateSquadName(ctx context.Context, squadId string, newName string) (err error) {
-	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.D{
-		{"$set", bson.D{{"name", newName}}},
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.M{
+		"$set": bson.M{"name": newName},
+	})
+	return
+}
+
+func (pdm *SquadDBManager) UpdateSquadPassword(ctx context.Context, squadId string, newPassword string) (err error) {
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.M{
+		"$set": bson.M{"password": newPassword},
 	})
 	return
 }
 
 func (pdm *SquadDBManager) UpdateSquadStatus(ctx context.Context, squadId string, newStatus bool) (err error) {
-	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.D{
-		{"$set", bson.D{{"status", newStatus}}},
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.M{
+		"$set": bson.M{"status": newStatus},
 	})
 	return
 }
 
 func (pdm *SquadDBManager) UpdateSquadMembers(ctx context.Context, squadId string, members []string) (err error) {
-	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.D{
-		{"$set", bson.D{{"members", members}}},
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.M{
+		"$set": bson.M{"members": members},
 	})
 	return
 }
 
 func (pdm *SquadDBManager) UpdateSquadAuthorizedMembers(ctx context.Context, squadId string, authorizedMembers []string) (err error) {
-	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.D{
-		{"$set", bson.D{{"authorizedMembers", authorizedMembers}}},
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.M{
+		"$set": bson.M{"authorizedmembers": authorizedMembers},
+	})
+	return
+}
+
+func (pdm *SquadDBManager) UpdateSquadType(ctx context.Context, squadId string, squadType SquadType) (err error) {
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.M{
+		"$set": bson.M{"squadtype": squadType},
+	})
+	return
+}
+
+func (pdm *SquadDBManager) UpdateSquadOwner(ctx context.Context, squadId string, owner string) (err error) {
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.M{
+		"$set": bson.M{"owner": owner},
+	})
+	return
+}
+
+func (pdm *SquadDBManager) UpdateSquadHost(ctx context.Context, squadId string, host string) (err error) {
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.M{
+		"$set": bson.M{"hostid": host},
+	})
+	return
+}
+
+func (pdm *SquadDBManager) UpdateSquadMeshLimit(ctx context.Context, squadId string, limit int) (err error) {
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.M{
+		"$set": bson.M{"meshlimit": limit},
+	})
+	return
+}
+
+func (pdm *SquadDBManager) UpdateSquadRoles(ctx context.Context, squadId string, roles SquadRoles) (err error) {
+	_, err = pdm.UpdateOne(ctx, bson.M{"id": squadId}, bson.M{
+		"$set": bson.M{"roles": roles},
+	})
+	return
+}
+
+func (pdm *SquadDBManager) invites() *mongo.Collection {
+	return pdm.Database().Collection(pdm.Name() + SQUAD_INVITE_COLLECTION_SUFFIX)
+}
+
+func (pdm *SquadDBManager) AddSquadInvite(ctx context.Context, invite *SquadInvite) (err error) {
+	_, err = pdm.invites().InsertOne(ctx, invite)
+	return
+}
+
+func (pdm *SquadDBManager) GetSquadInvite(ctx context.Context, code string) (invite *SquadInvite, err error) {
+	err = pdm.invites().FindOne(ctx, bson.M{"code": code}).Decode(&invite)
+	return
+}
+
+func (pdm *SquadDBManager) GetSquadInvites(ctx context.Context, squadId string) (invites []*SquadInvite, err error) {
+	res, err := pdm.invites().Find(ctx, bson.M{"squadid": squadId})
+	if err != nil {
+		return
+	}
+	err = res.All(ctx, &invites)
+	return
+}
+
+func (pdm *SquadDBManager) UseSquadInvite(ctx context.Context, code string, now time.Time) (err error) {
+	res, err := pdm.invites().UpdateOne(ctx, bson.M{
+		"code":    code,
+		"revoked": false,
+		"$and": bson.A{
+			bson.M{"$or": bson.A{bson.M{"expiresat": 0}, bson.M{"expiresat": bson.M{"$gt": now.Unix()}}}},
+			bson.M{"$or": bson.A{bson.M{"maxuses": 0}, bson.M{"$expr": bson.M{"$lt": bson.A{"$uses", "$maxuses"}}}}},
+		},
+	}, bson.M{
+		"$inc": bson.M{"uses": 1},
 	})
+	if err != nil {
+		return
+	}
+	if res.ModifiedCount == 0 {
+		err = fmt.Errorf("this invite is no longer valid")
+	}
+	return
+}
+
+func (pdm *SquadDBManager) RevokeSquadInvite(ctx context.Context, code string) (err error) {
+	_, err = pdm.invites().UpdateOne(ctx, bson.M{"code": code}, bson.M{
+		"$set": bson.M{"revoked": true},
+	})
+	return
+}
+
+func (pdm *SquadDBManager) GetSquadsByRole(ctx context.Context, peerId string, role SquadRole, limit int64, lastIndex int64) (squads []*Squad, err error) {
+	res, err := pdm.Find(ctx, bson.M{"roles": bson.M{"$elemMatch": bson.M{"peer": peerId, "role": role}}}, options.Find().SetLimit(limit).SetSkip(lastIndex))
+	if err != nil {
+		return
+	}
+	err = res.All(ctx, &squads)
+	return
+}
+
+func (pdm *SquadDBManager) GetSquadsByMember(ctx context.Context, peerId string, limit int64, lastIndex int64) (squads []*Squad, err error) {
+	res, err := pdm.Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"owner": peerId},
+		bson.M{"members": peerId},
+		bson.M{"authorizedmembers": peerId},
+	}}, options.Find().SetLimit(limit).SetSkip(lastIndex))
+	if err != nil {
+		return
+	}
+	err = res.All(ctx, &squads)
+	return
+}
+
+func (pdm *SquadDBManager) joinRequests() *mongo.Collection {
+	return pdm.Database().Collection(pdm.Name() + SQUAD_JOIN_REQUEST_COLLECTION_SUFFIX)
+}
+
+func (pdm *SquadDBManager) AddSquadJoinRequest(ctx context.Context, request *SquadJoinRequest) (err error) {
+	_, err = pdm.joinRequests().InsertOne(ctx, request)
+	return
+}
+
+func (pdm *SquadDBManager) GetSquadJoinRequest(ctx context.Context, requestId string) (request *SquadJoinRequest, err error) {
+	err = pdm.joinRequests().FindOne(ctx, bson.M{"id": requestId}).Decode(&request)
+	return
+}
+
+func (pdm *SquadDBManager) GetSquadJoinRequests(ctx context.Context, squadId string) (requests []*SquadJoinRequest, err error) {
+	res, err := pdm.joinRequests().Find(ctx, bson.M{"squadid": squadId}, options.Find().SetSort(bson.M{"createdat": 1}))
+	if err != nil {
+		return
+	}
+	err = res.All(ctx, &requests)
+	return
+}
+
+func (pdm *SquadDBManager) DeleteSquadJoinRequest(ctx context.Context, requestId string) (err error) {
+	_, err = pdm.joinRequests().DeleteOne(ctx, bson.M{"id": requestId})
 	return
 }
@@ -0,0 +1,149 @@
+package manager
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+)
+
+type SquadInvite struct {
+	Code       string
+	SquadId    string
+	CreatedBy  string
+	CreatedAt  int64
+	ExpiresAt  int64
+	MaxUses    int
+	Uses       int
+	TargetPeer string
+	Role       SquadRole
+	Revoked    bool
+}
+
+const SQUAD_INVITE_CODE_SIZE = 16
+
+func (invite *SquadInvite) Valid(now time.Time) bool {
+	return !invite.Revoked &&
+		(invite.ExpiresAt == 0 || now.Unix() < invite.ExpiresAt) &&
+		(invite.MaxUses == 0 || invite.Uses < invite.MaxUses)
+}
+
+func newSquadInviteCode() (code string, err error) {
+	b := make([]byte, SQUAD_INVITE_CODE_SIZE)
+	if _, err = rand.Read(b); err != nil {
+		return
+	}
+	code = base64.RawURLEncoding.EncodeToString(b)
+	return
+}
+
+func (manager *Manager) CreateSquadInvite(token string, squadId string, from string, ttl time.Duration, maxUses int, targetPeer string, role SquadRole) (invite *SquadInvite, err error) {
+	squad, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_MANAGE_INVITES)
+	if err != nil {
+		return
+	}
+	if role == "" {
+		role = MEMBER
+	}
+	if role != MEMBER && role != MODERATOR && role != ADMIN {
+		err = fmt.Errorf("the role %s cannot be granted by an invite", role)
+		return
+	}
+	if role != MEMBER && !squad.Role(from).Outranks(role) {
+		err = fmt.Errorf("you cannot invite a peer as %s of squad %s", role, squadId)
+		return
+	}
+	if maxUses < 0 {
+		err = fmt.Errorf("the maximum number of uses cannot be negative")
+		return
+	}
+	if targetPeer != "" && squad.Role(targetPeer) == BANNED {
+		err = fmt.Errorf("peer %s is banned from squad %s", targetPeer, squadId)
+		return
+	}
+	code, err := newSquadInviteCode()
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	invite = &SquadInvite{
+		Code:       code,
+		SquadId:    squadId,
+		CreatedBy:  from,
+		CreatedAt:  now.Unix(),
+		MaxUses:    maxUses,
+		TargetPeer: targetPeer,
+		Role:       role,
+	}
+	if ttl > 0 {
+		invite.ExpiresAt = now.Add(ttl).Unix()
+	}
+	if err = store.AddSquadInvite(context.Background(), invite); err != nil {
+		invite = nil
+	}
+	return
+}
+
+func (manager *Manager) ListSquadInvites(token string, squadId string, from string) (invites []*SquadInvite, err error) {
+	_, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_MANAGE_INVITES)
+	if err != nil {
+		return
+	}
+	invites, err = store.GetSquadInvites(context.Background(), squadId)
+	return
+}
+
+func (manager *Manager) RevokeSquadInvite(token string, squadId string, from string, code string) (err error) {
+	_, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_MANAGE_INVITES)
+	if err != nil {
+		return
+	}
+	invite, err := store.GetSquadInvite(context.Background(), code)
+	if err != nil {
+		return
+	}
+	if invite.SquadId != squadId {
+		err = fmt.Errorf("no invite %s for squad %s", code, squadId)
+		return
+	}
+	err = store.RevokeSquadInvite(context.Background(), code)
+	return
+}
+
+func (manager *Manager) JoinSquadByInvite(token string, from string, code string) (squadId string, err error) {
+	for _, store := range []SquadStore{manager.SquadStore, manager.HostedSquadStore} {
+		invite, e := store.GetSquadInvite(context.Background(), code)
+		if e != nil {
+			continue
+		}
+		squad, e := store.GetSquad(context.Background(), invite.SquadId)
+		if e != nil || squad == nil {
+			continue
+		}
+		squadId = squad.ID
+		err = manager.ConnectToSquad(token, squad.ID, from, "", code, squad.NetworkType)
+		return
+	}
+	err = fmt.Errorf("this invite is not valid")
+	return
+}
+
+func (manager *Manager) useSquadInvite(store SquadStore, squad *Squad, from string, code string) (err error) {
+	invite, err := store.GetSquadInvite(context.Background(), code)
+	if err != nil || invite.SquadId != squad.ID || (invite.TargetPeer != "" && invite.TargetPeer != from) {
+		err = fmt.Errorf("this invite is not valid")
+		return
+	}
+	if err = store.UseSquadInvite(context.Background(), code, time.Now()); err != nil {
+		return
+	}
+	if invite.Role != MEMBER && invite.Role.Outranks(squad.Role(from)) {
+		if squad.Roles == nil {
+			squad.Roles = make(SquadRoles)
+		}
+		squad.Roles[from] = invite.Role
+		err = store.UpdateSquadRoles(context.Background(), squad.ID, squad.Roles)
+	}
+	return
+}
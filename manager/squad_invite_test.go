@@ -0,0 +1,70 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSquadInvites(t *testing.T) {
+	m := NewMemoryManager()
+	tokens := map[string]string{}
+	for _, peer := range []string{"lolo", "lolo2", "lolo3", "lolo4"} {
+		tokens[peer] = newTestSession(t, m, peer)
+	}
+	if err := m.CreateSquad(tokens["lolo"], "0xff", "lolo", "test squad", PRIVATE, "lolo2001", HOSTED, "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.CreateSquadInvite(tokens["lolo2"], "0xff", "lolo2", 0, 0, "", MEMBER); err == nil {
+		t.Error("expected a non member to be unable to create an invite")
+	}
+	invite, err := m.CreateSquadInvite(tokens["lolo"], "0xff", "lolo", time.Hour, 1, "", MODERATOR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if squadId, err := m.JoinSquadByInvite(tokens["lolo2"], "lolo2", invite.Code); err != nil || squadId != "0xff" {
+		t.Fatalf("expected lolo2 to join 0xff, got %s %v", squadId, err)
+	}
+	if err = m.ConnectToSquad(tokens["lolo3"], "0xff", "lolo3", "", invite.Code, HOSTED); err == nil {
+		t.Error("expected a used up invite to be rejected")
+	}
+	targeted, err := m.CreateSquadInvite(tokens["lolo"], "0xff", "lolo", 0, 0, "lolo4", MEMBER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.JoinSquadByInvite(tokens["lolo3"], "lolo3", targeted.Code); err == nil {
+		t.Error("expected an invite targeting lolo4 to be rejected for lolo3")
+	}
+	if err = m.RevokeSquadInvite(tokens["lolo"], "0xff", "lolo", targeted.Code); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.JoinSquadByInvite(tokens["lolo4"], "lolo4", targeted.Code); err == nil {
+		t.Error("expected a revoked invite to be rejected")
+	}
+	expired := &SquadInvite{Code: "expired", SquadId: "0xff", ExpiresAt: time.Now().Add(-time.Minute).Unix(), Role: MEMBER}
+	if err = m.HostedSquadStore.AddSquadInvite(context.Background(), expired); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.JoinSquadByInvite(tokens["lolo4"], "lolo4", expired.Code); err == nil {
+		t.Error("expected an expired invite to be rejected")
+	}
+	invites, err := m.ListSquadInvites(tokens["lolo"], "0xff", "lolo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invites) != 3 {
+		t.Errorf("expected 3 invites, got %d", len(invites))
+	}
+	for _, i := range invites {
+		if i.Code == invite.Code && i.Uses != 1 {
+			t.Errorf("expected the invite to be used once, got %d", i.Uses)
+		}
+	}
+	squad, err := m.HostedSquadStore.GetSquad(context.Background(), "0xff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if squad.Role("lolo2") != MODERATOR || squad.Role("lolo3") != "" || squad.Role("lolo4") != "" {
+		t.Errorf("unexpected squad %+v", squad)
+	}
+}
@@ -0,0 +1,188 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SquadJoinRequest struct {
+	Id        string
+	SquadId   string
+	PeerId    string
+	Message   string
+	CreatedAt int64
+}
+
+const (
+	SQUAD_JOIN_REQUEST          SquadEvent = "join_request"
+	SQUAD_JOIN_REQUEST_APPROVED SquadEvent = "join_request_approved"
+	SQUAD_JOIN_REQUEST_DENIED   SquadEvent = "join_request_denied"
+)
+
+const MAX_SQUAD_JOIN_REQUEST_MESSAGE = 500
+
+func (request *SquadJoinRequest) payload() map[string]string {
+	return map[string]string{
+		"requestId": request.Id,
+		"squadId":   request.SquadId,
+		"id":        request.PeerId,
+		"message":   request.Message,
+		"createdAt": fmt.Sprint(request.CreatedAt),
+	}
+}
+
+func squadApprovers(squad *Squad) (approvers []string) {
+	approvers = []string{squad.Owner}
+	for peerId, role := range squad.Roles {
+		if role.Can(SQUAD_MANAGE_JOIN_REQUESTS) && peerId != squad.Owner {
+			approvers = append(approvers, peerId)
+		}
+	}
+	return
+}
+
+func (manager *Manager) RequestToJoinSquad(token string, squadId string, from string, message string) (request *SquadJoinRequest, err error) {
+	if err = manager.authenticateSquad(token, from, squadId); err != nil {
+		return
+	}
+	squad, store, err := manager.findSquad(squadId)
+	if err != nil {
+		return
+	}
+	switch squad.Role(from) {
+	case BANNED:
+		err = fmt.Errorf("access denied : you are banned from this squad")
+		return
+	case "":
+	default:
+		err = fmt.Errorf("you are already a member of squad %s", squadId)
+		return
+	}
+	if squad.SquadType != PRIVATE {
+		err = fmt.Errorf("squad %s is public, join it directly", squadId)
+		return
+	}
+	if len(message) > MAX_SQUAD_JOIN_REQUEST_MESSAGE {
+		err = fmt.Errorf("the join request message cannot exceed %d characters", MAX_SQUAD_JOIN_REQUEST_MESSAGE)
+		return
+	}
+	pending, err := store.GetSquadJoinRequests(context.Background(), squadId)
+	if err != nil {
+		return
+	}
+	for _, p := range pending {
+		if p.PeerId == from {
+			err = fmt.Errorf("you already asked to join squad %s", squadId)
+			return
+		}
+	}
+	request = &SquadJoinRequest{
+		Id:        uuid.NewString(),
+		SquadId:   squadId,
+		PeerId:    from,
+		Message:   message,
+		CreatedAt: time.Now().Unix(),
+	}
+	if err = store.AddSquadJoinRequest(context.Background(), request); err != nil {
+		request = nil
+		return
+	}
+	manager.notifySquad(squadApprovers(squad), from, SQUAD_JOIN_REQUEST, request.payload())
+	return
+}
+
+func (manager *Manager) ListSquadJoinRequests(token string, squadId string, from string) (requests []*SquadJoinRequest, err error) {
+	_, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_MANAGE_JOIN_REQUESTS)
+	if err != nil {
+		return
+	}
+	requests, err = store.GetSquadJoinRequests(context.Background(), squadId)
+	return
+}
+
+func (manager *Manager) AnswerSquadJoinRequest(token string, squadId string, from string, requestId string, approve bool) (err error) {
+	squad, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_MANAGE_JOIN_REQUESTS)
+	if err != nil {
+		return
+	}
+	request, err := store.GetSquadJoinRequest(context.Background(), requestId)
+	if err != nil || request.SquadId != squadId {
+		err = fmt.Errorf("no join request %s for squad %s", requestId, squadId)
+		return
+	}
+	if approve && squad.Role(request.PeerId) == BANNED {
+		err = fmt.Errorf("peer %s is banned from squad %s", request.PeerId, squadId)
+		return
+	}
+	if !approve {
+		if err = store.DeleteSquadJoinRequest(context.Background(), requestId); err != nil {
+			return
+		}
+		manager.notifySquad([]string{request.PeerId}, from, SQUAD_JOIN_REQUEST_DENIED, request.payload())
+		return
+	}
+	if !containsPeer(squad.AuthorizedMembers, request.PeerId) {
+		if err = store.UpdateSquadAuthorizedMembers(context.Background(), squadId, append(squad.AuthorizedMembers, request.PeerId)); err != nil {
+			return
+		}
+	}
+	members := squad.Members
+	if !containsPeer(members, request.PeerId) {
+		members = append(append([]string{}, members...), request.PeerId)
+	}
+	if err = store.UpdateSquadMembers(context.Background(), squadId, members); err != nil {
+		return
+	}
+	if err = store.DeleteSquadJoinRequest(context.Background(), requestId); err != nil {
+		return
+	}
+	incoming := INCOMING_MEMBER
+	if squad.NetworkType == HOSTED {
+		incoming = HOSTED_INCOMING_MEMBER
+	}
+	manager.notifySquad(squad.Members, request.PeerId, incoming, map[string]string{"id": request.PeerId})
+	manager.notifySquad([]string{request.PeerId}, from, SQUAD_JOIN_REQUEST_APPROVED, request.payload())
+	squad.Members = members
+	manager.meshJoined(squad, request.PeerId)
+	return
+}
+
+func (manager *Manager) deliverPendingJoinRequests(peerId string) {
+	for _, store := range []SquadStore{manager.SquadStore, manager.HostedSquadStore} {
+		squads, err := store.GetSquadsByOwner(context.Background(), peerId, 100, 0)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		for _, role := range rolesWith(SQUAD_MANAGE_JOIN_REQUESTS) {
+			if role == OWNER {
+				continue
+			}
+			withRole, err := store.GetSquadsByRole(context.Background(), peerId, role, 100, 0)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			squads = append(squads, withRole...)
+		}
+		delivered := make(map[string]bool, len(squads))
+		for _, squad := range squads {
+			if delivered[squad.ID] {
+				continue
+			}
+			delivered[squad.ID] = true
+			requests, err := store.GetSquadJoinRequests(context.Background(), squad.ID)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			for _, request := range requests {
+				manager.notifySquad([]string{peerId}, request.PeerId, SQUAD_JOIN_REQUEST, request.payload())
+			}
+		}
+	}
+}
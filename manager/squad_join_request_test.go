@@ -0,0 +1,156 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSquadJoinRequests(t *testing.T) {
+	m := NewMemoryManager()
+	url := newTestWSServer(t, m)
+	tokens := map[string]string{}
+	for _, peer := range []string{"lolo", "lolo2", "lolo3", "lolo4"} {
+		tokens[peer] = newTestSession(t, m, peer)
+	}
+	if err := m.CreateSquad(tokens["lolo"], "0xff", "lolo", "test squad", PRIVATE, "lolo2001", HOSTED, "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.ConnectToSquad(tokens["lolo3"], "0xff", "lolo3", "lolo2001", "", HOSTED); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.SetSquadRole(tokens["lolo"], "0xff", "lolo", "lolo3", ADMIN); err != nil {
+		t.Fatal(err)
+	}
+	owner := dialTestWS(t, url+"?token="+tokens["lolo"])
+	requester := dialTestWS(t, url+"?token="+tokens["lolo2"])
+	for _, conn := range []string{"lolo", "lolo2"} {
+		c := owner
+		if conn == "lolo2" {
+			c = requester
+		}
+		if err := c.WriteJSON(&ServRequest{Type: WS_INIT}); err != nil {
+			t.Fatal(err)
+		}
+		waitForWSPeer(t, m, conn)
+	}
+	request, err := m.RequestToJoinSquad(tokens["lolo2"], "0xff", "lolo2", "let me in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.RequestToJoinSquad(tokens["lolo2"], "0xff", "lolo2", "again"); err == nil {
+		t.Error("expected a second pending request to be rejected")
+	}
+	if msg := readTestWS(t, owner); msg["type"] != string(SQUAD_JOIN_REQUEST) {
+		t.Errorf("expected the owner to receive the join request, got %v", msg)
+	}
+	admin := dialTestWS(t, url+"?token="+tokens["lolo3"])
+	if err = admin.WriteJSON(&ServRequest{Type: WS_INIT}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := readTestWS(t, admin); msg["type"] != string(SQUAD_JOIN_REQUEST) || msg["payload"].(map[string]interface{})["requestId"] != request.Id {
+		t.Errorf("expected the admin to receive the pending request on connection, got %v", msg)
+	}
+	admin.Close()
+	for i := 0; i < 100; i++ {
+		m.Router.RLock()
+		_, ok := m.Router.WSPeers["lolo3"]
+		m.Router.RUnlock()
+		if !ok {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err = m.AnswerSquadJoinRequest(tokens["lolo2"], "0xff", "lolo2", request.Id, true); err == nil {
+		t.Error("expected the requester to be unable to approve its own request")
+	}
+	if err = m.AnswerSquadJoinRequest(tokens["lolo3"], "0xff", "lolo3", request.Id, true); err != nil {
+		t.Fatal(err)
+	}
+	if msg := readTestWS(t, requester); msg["type"] != string(SQUAD_JOIN_REQUEST_APPROVED) {
+		t.Errorf("expected the requester to be notified, got %v", msg)
+	}
+	denied, err := m.RequestToJoinSquad(tokens["lolo4"], "0xff", "lolo4", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.BanFromSquad(tokens["lolo"], "0xff", "lolo", "lolo4"); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.AnswerSquadJoinRequest(tokens["lolo"], "0xff", "lolo", denied.Id, true); err == nil {
+		t.Error("expected the request of a banned peer to be impossible to approve")
+	}
+	if requests, _ := m.ListSquadJoinRequests(tokens["lolo"], "0xff", "lolo"); len(requests) != 1 || requests[0].Id != denied.Id {
+		t.Errorf("expected the refused approval to leave the request pending, got %v", requests)
+	}
+	if err = m.AnswerSquadJoinRequest(tokens["lolo"], "0xff", "lolo", denied.Id, false); err != nil {
+		t.Fatal(err)
+	}
+	requests, err := m.ListSquadJoinRequests(tokens["lolo"], "0xff", "lolo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 0 {
+		t.Errorf("expected no pending request, got %v", requests)
+	}
+	squad, err := m.HostedSquadStore.GetSquad(context.Background(), "0xff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if squad.Role("lolo2") != MEMBER || squad.Role("lolo4") != BANNED {
+		t.Errorf("unexpected squad %+v", squad)
+	}
+}
+
+type failingMembersStore struct {
+	SquadStore
+}
+
+func (store *failingMembersStore) UpdateSquadMembers(ctx context.Context, id string, members []string) error {
+	return fmt.Errorf("members of squad %s cannot be updated", id)
+}
+
+func TestSquadJoinRequestRetries(t *testing.T) {
+	m := NewMemoryManager()
+	tokens := map[string]string{}
+	for _, peer := range []string{"lolo", "lolo2", "lolo3"} {
+		tokens[peer] = newTestSession(t, m, peer)
+	}
+	if err := m.CreateSquad(tokens["lolo"], "0xff", "lolo", "test squad", PRIVATE, "lolo2001", HOSTED, "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.ConnectToSquad(tokens["lolo3"], "0xff", "lolo3", "lolo2001", "", HOSTED); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.SetSquadRole(tokens["lolo"], "0xff", "lolo", "lolo3", MODERATOR); err != nil {
+		t.Fatal(err)
+	}
+	request, err := m.RequestToJoinSquad(tokens["lolo2"], "0xff", "lolo2", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := m.HostedSquadStore
+	m.HostedSquadStore = &failingMembersStore{SquadStore: store}
+	if err = m.AnswerSquadJoinRequest(tokens["lolo"], "0xff", "lolo", request.Id, true); err == nil {
+		t.Error("expected the approval to fail with the members update")
+	}
+	m.HostedSquadStore = store
+	if requests, _ := m.ListSquadJoinRequests(tokens["lolo"], "0xff", "lolo"); len(requests) != 1 {
+		t.Fatalf("expected the request to stay pending for another try, got %v", requests)
+	}
+	permissions := squadPermissions[MODERATOR]
+	squadPermissions[MODERATOR] = append(append([]SquadPermission{}, permissions...), SQUAD_MANAGE_JOIN_REQUESTS)
+	defer func() { squadPermissions[MODERATOR] = permissions }()
+	stream, cancel := linkTestPeer(t, m, "lolo3")
+	defer cancel()
+	if res := nextEvent(t, stream, string(SQUAD_JOIN_REQUEST)); res.Payload["requestId"] != request.Id {
+		t.Errorf("expected a moderator allowed to answer join requests to receive the pending one, got %v", res.Payload)
+	}
+	if err = m.AnswerSquadJoinRequest(tokens["lolo3"], "0xff", "lolo3", request.Id, true); err != nil {
+		t.Fatal(err)
+	}
+	if requests, _ := m.ListSquadJoinRequests(tokens["lolo"], "0xff", "lolo"); len(requests) != 0 {
+		t.Errorf("expected the approved request to be removed, got %v", requests)
+	}
+}
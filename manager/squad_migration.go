@@ -0,0 +1,165 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+const NETWORK_TYPE_CHANGED SquadEvent = "network_type_changed"
+
+const DEFAULT_MESH_MAX_MEMBERS = 8
+
+func (manager *Manager) meshLimit(squad *Squad) int {
+	if squad.MeshLimit > 0 {
+		return squad.MeshLimit
+	}
+	return manager.Mesh.Config.MaxMembers
+}
+
+func distinctMembers(squad *Squad) int {
+	members := make(map[string]bool, len(squad.Members))
+	for _, member := range squad.Members {
+		members[member] = true
+	}
+	return len(members)
+}
+
+func (manager *Manager) SetSquadMeshLimit(token string, squadId string, from string, limit int) (err error) {
+	squad, store, err := manager.authorizeSquad(token, from, squadId, SQUAD_CHANGE_NETWORK)
+	if err != nil {
+		return
+	}
+	if limit < 0 {
+		err = fmt.Errorf("the mesh limit of a squad cannot be negative")
+		return
+	}
+	if err = store.UpdateSquadMeshLimit(context.Background(), squadId, limit); err != nil {
+		return
+	}
+	squad.MeshLimit = limit
+	manager.migrateSquad(squad)
+	return
+}
+
+func (manager *Manager) migrateSquad(squad *Squad) (migrated bool) {
+	limit := manager.meshLimit(squad)
+	if limit <= 0 {
+		return
+	}
+	members := distinctMembers(squad)
+	switch {
+	case squad.NetworkType == MESH && members > limit:
+		hostId, err := manager.ElectHost("", squad.ID)
+		if err != nil {
+			log.Printf("squad %s stays a mesh : %v\n", squad.ID, err)
+			return
+		}
+		if migrated = manager.moveSquad(squad, HOSTED, hostId); !migrated {
+			manager.Hosts.release(hostId, squad.ID)
+		}
+	case squad.NetworkType == HOSTED && squad.Migrated && members <= limit/2:
+		migrated = manager.moveSquad(squad, MESH, "")
+	}
+	return
+}
+
+func (manager *Manager) moveSquad(squad *Squad, networkType SquadNetworkType, hostId string) bool {
+	from, err := manager.squadStoreFor(squad.NetworkType)
+	if err != nil {
+		log.Println(err)
+		return false
+	}
+	to, err := manager.squadStoreFor(networkType)
+	if err != nil {
+		log.Println(err)
+		return false
+	}
+	ctx, previousHost := context.Background(), squad.HostId
+	moved := copySquad(squad)
+	moved.NetworkType, moved.HostId, moved.Migrated = networkType, hostId, networkType == HOSTED
+	if err = to.AddNewSquad(ctx, moved); err != nil {
+		log.Println(err)
+		return false
+	}
+	if err = from.DeleteSquad(ctx, squad.ID); err != nil {
+		log.Println(err)
+		if e := to.DeleteSquad(ctx, squad.ID); e != nil {
+			log.Println(e)
+		}
+		return false
+	}
+	moveSquadRequests(ctx, from, to, squad.ID)
+	manager.SignalGrants.revokeSquad(squad.ID)
+	squad.NetworkType, squad.HostId, squad.Migrated = moved.NetworkType, moved.HostId, moved.Migrated
+	manager.Lock()
+	if s, ok := manager.Squads[squad.ID]; ok {
+		s.NetworkType, s.HostId, s.Migrated = moved.NetworkType, moved.HostId, moved.Migrated
+	}
+	manager.Unlock()
+	host := hostId
+	if networkType == HOSTED {
+		manager.Mesh.remove(squad.ID)
+	} else {
+		manager.Hosts.release(previousHost, squad.ID)
+		host = previousHost
+	}
+	recipients := squad.Members
+	if host != "" && !containsPeer(recipients, host) {
+		recipients = append(append([]string{}, recipients...), host)
+	}
+	manager.notifySquad(recipients, "", NETWORK_TYPE_CHANGED, map[string]string{
+		"squadId":     squad.ID,
+		"networkType": networkType,
+		"hostId":      hostId,
+	})
+	if networkType == MESH {
+		manager.meshTopology(squad)
+	}
+	return true
+}
+
+func moveSquadRequests(ctx context.Context, from SquadStore, to SquadStore, squadId string) {
+	invites, err := from.GetSquadInvites(ctx, squadId)
+	if err != nil {
+		log.Println(err)
+	}
+	for _, invite := range invites {
+		if err = to.AddSquadInvite(ctx, invite); err == nil {
+			err = from.RevokeSquadInvite(ctx, invite.Code)
+		}
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	requests, err := from.GetSquadJoinRequests(ctx, squadId)
+	if err != nil {
+		log.Println(err)
+	}
+	for _, request := range requests {
+		if err = to.AddSquadJoinRequest(ctx, request); err == nil {
+			err = from.DeleteSquadJoinRequest(ctx, request.Id)
+		}
+		if err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+func (manager *Manager) meshTopology(squad *Squad) {
+	members := append([]string{}, squad.Members...)
+	sort.Strings(members)
+	manager.Mesh.remove(squad.ID)
+	for i, member := range members {
+		manager.Mesh.join(squad.ID, member, members[:i])
+	}
+	for i, member := range members {
+		manager.notifySquad([]string{member}, "", MESH_TOPOLOGY, map[string]string{
+			"squadId":    squad.ID,
+			"offerTo":    strings.Join(members[i+1:], ","),
+			"answerFrom": strings.Join(members[:i], ","),
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSquadMigration(t *testing.T) {
+	m := NewMemoryManager()
+	tokens := map[string]string{}
+	streams := map[string]*testLinkStream{}
+	for _, peer := range []string{"lolo", "lolo2", "lolo3", "lolo4", "host1"} {
+		tokens[peer] = newTestSession(t, m, peer)
+		stream, cancel := linkTestPeer(t, m, peer)
+		defer cancel()
+		streams[peer] = stream
+	}
+	if _, err := m.RegisterHost(tokens["host1"], "host1", "eu", 4, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.CreateSquad(tokens["lolo"], "0xff", "lolo", "test squad", PUBLIC, "", MESH, ""); err != nil {
+		t.Fatal(err)
+	}
+	for _, peer := range []string{"lolo", "lolo2"} {
+		if err := m.ConnectToSquad(tokens[peer], "0xff", peer, "", "", MESH); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.SetSquadMeshLimit(tokens["lolo2"], "0xff", "lolo2", 2); err == nil {
+		t.Error("expected a member to be unable to change the mesh limit")
+	}
+	if err := m.SetSquadMeshLimit(tokens["lolo"], "0xff", "lolo", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.ConnectToSquad(tokens["lolo2"], "0xff", "lolo2", "", "", MESH); err != nil {
+		t.Fatal(err)
+	}
+	if squad, err := m.SquadStore.GetSquad(context.Background(), "0xff"); err != nil || len(squad.Members) != 2 {
+		t.Errorf("expected a reconnecting member to be counted once and the squad to stay a mesh, got %v %v", squad, err)
+	}
+	invite, err := m.CreateSquadInvite(tokens["lolo"], "0xff", "lolo", time.Hour, 0, "", MEMBER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.ConnectToSquad(tokens["lolo3"], "0xff", "lolo3", "", "", MESH); err != nil {
+		t.Fatal(err)
+	}
+	for _, peer := range []string{"lolo", "lolo2", "host1"} {
+		if res := nextEvent(t, streams[peer], string(NETWORK_TYPE_CHANGED)); res.Payload["networkType"] != HOSTED || res.Payload["hostId"] != "host1" {
+			t.Errorf("expected %s to be told the squad moved to host1, got %v", peer, res.Payload)
+		}
+	}
+	if _, err = m.SquadStore.GetSquad(context.Background(), "0xff"); err == nil {
+		t.Error("expected the squad to leave the mesh store")
+	}
+	squad, err := m.HostedSquadStore.GetSquad(context.Background(), "0xff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if squad.HostId != "host1" || !squad.Migrated || len(squad.Members) != 3 || squad.MeshLimit != 2 {
+		t.Errorf("expected the squad to be hosted by host1 with its members, got %+v", squad)
+	}
+	if _, err = m.JoinSquadByInvite(tokens["lolo4"], "lolo4", invite.Code); err != nil {
+		t.Errorf("expected invites to follow the squad, got %v", err)
+	}
+	for _, peer := range []string{"lolo4", "lolo3", "lolo2"} {
+		if err = m.LeaveSquad(tokens[peer], "0xff", peer, MESH); err != nil {
+			t.Errorf("expected %s to leave the moved squad with its former network type, got %v", peer, err)
+		}
+	}
+	if res := nextEvent(t, streams["host1"], string(NETWORK_TYPE_CHANGED)); res.Payload["networkType"] != MESH || res.Payload["hostId"] != "" {
+		t.Errorf("expected host1 to be released, got %v", res.Payload)
+	}
+	if res := nextEvent(t, streams["lolo"], string(NETWORK_TYPE_CHANGED)); res.Payload["networkType"] != MESH {
+		t.Errorf("expected lolo to be told the squad is a mesh again, got %v", res.Payload)
+	}
+	if res := nextEvent(t, streams["lolo"], string(MESH_TOPOLOGY)); res.Payload["offerTo"] != "" || res.Payload["answerFrom"] != "" {
+		t.Errorf("expected lolo to be alone in the mesh, got %v", res.Payload)
+	}
+	if _, err = m.SquadStore.GetSquad(context.Background(), "0xff"); err != nil {
+		t.Errorf("expected the squad to be back in the mesh store, got %v", err)
+	}
+	if hosts, _ := m.ListHosts(tokens["lolo"], "lolo", ""); len(hosts) != 1 || hosts[0].Load != 0 {
+		t.Errorf("expected host1 to have no squad left, got %v", hosts)
+	}
+}
@@ -0,0 +1,125 @@
+package manager
+
+import (
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+type (
+	SquadRole       string
+	SquadPermission string
+	SquadRoles      map[string]SquadRole
+)
+
+type squadRoleEntry struct {
+	Peer string    `bson:"peer"`
+	Role SquadRole `bson:"role"`
+}
+
+const (
+	OWNER     SquadRole = "owner"
+	ADMIN     SquadRole = "admin"
+	MODERATOR SquadRole = "moderator"
+	MEMBER    SquadRole = "member"
+	BANNED    SquadRole = "banned"
+)
+
+const (
+	SQUAD_RENAME               SquadPermission = "rename"
+	SQUAD_CHANGE_PASSWORD      SquadPermission = "change_password"
+	SQUAD_INVITE               SquadPermission = "invite"
+	SQUAD_KICK                 SquadPermission = "kick"
+	SQUAD_BAN                  SquadPermission = "ban"
+	SQUAD_DELETE               SquadPermission = "delete"
+	SQUAD_TRANSFER_OWNERSHIP   SquadPermission = "transfer_ownership"
+	SQUAD_MANAGE_ROLES         SquadPermission = "manage_roles"
+	SQUAD_MANAGE_INVITES       SquadPermission = "manage_invites"
+	SQUAD_MANAGE_JOIN_REQUESTS SquadPermission = "manage_join_requests"
+	SQUAD_CHANGE_NETWORK       SquadPermission = "change_network"
+)
+
+var squadPermissions = map[SquadRole][]SquadPermission{
+	OWNER:     {SQUAD_RENAME, SQUAD_CHANGE_PASSWORD, SQUAD_INVITE, SQUAD_KICK, SQUAD_BAN, SQUAD_DELETE, SQUAD_TRANSFER_OWNERSHIP, SQUAD_MANAGE_ROLES, SQUAD_MANAGE_INVITES, SQUAD_MANAGE_JOIN_REQUESTS, SQUAD_CHANGE_NETWORK},
+	ADMIN:     {SQUAD_RENAME, SQUAD_CHANGE_PASSWORD, SQUAD_INVITE, SQUAD_KICK, SQUAD_BAN, SQUAD_MANAGE_ROLES, SQUAD_MANAGE_INVITES, SQUAD_MANAGE_JOIN_REQUESTS, SQUAD_CHANGE_NETWORK},
+	MODERATOR: {SQUAD_INVITE, SQUAD_KICK},
+}
+
+var squadRoleRanks = map[SquadRole]int{
+	OWNER:     4,
+	ADMIN:     3,
+	MODERATOR: 2,
+	MEMBER:    1,
+}
+
+func (role SquadRole) Can(permission SquadPermission) bool {
+	for _, p := range squadPermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func rolesWith(permission SquadPermission) (roles []SquadRole) {
+	for role := range squadPermissions {
+		if role.Can(permission) {
+			roles = append(roles, role)
+		}
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Outranks(roles[j]) })
+	return
+}
+
+func (role SquadRole) Outranks(other SquadRole) bool {
+	return squadRoleRanks[role] > squadRoleRanks[other]
+}
+
+func (squad *Squad) Role(peerId string) SquadRole {
+	if peerId == squad.Owner {
+		return OWNER
+	}
+	if role, ok := squad.Roles[peerId]; ok {
+		return role
+	}
+	for _, member := range append(append([]string{}, squad.Members...), squad.AuthorizedMembers...) {
+		if member == peerId {
+			return MEMBER
+		}
+	}
+	return ""
+}
+
+func (roles SquadRoles) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	entries := make([]squadRoleEntry, 0, len(roles))
+	for peerId, role := range roles {
+		entries = append(entries, squadRoleEntry{Peer: peerId, Role: role})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Peer < entries[j].Peer })
+	return bson.MarshalValue(entries)
+}
+
+func (roles *SquadRoles) UnmarshalBSONValue(t bsontype.Type, data []byte) (err error) {
+	value := bson.RawValue{Type: t, Value: data}
+	switch t {
+	case bsontype.Null:
+		*roles = nil
+	case bsontype.EmbeddedDocument:
+		legacy := make(map[string]SquadRole)
+		if err = value.Unmarshal(&legacy); err != nil {
+			return
+		}
+		*roles = legacy
+	default:
+		entries := make([]squadRoleEntry, 0)
+		if err = value.Unmarshal(&entries); err != nil {
+			return
+		}
+		*roles = make(SquadRoles, len(entries))
+		for _, entry := range entries {
+			(*roles)[entry.Peer] = entry.Role
+		}
+	}
+	return
+}
@@ -0,0 +1,89 @@
+package manager
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSquadPermissionMatrix(t *testing.T) {
+	for _, c := range []struct {
+		role       SquadRole
+		permission SquadPermission
+		allowed    bool
+	}{
+		{OWNER, SQUAD_TRANSFER_OWNERSHIP, true},
+		{OWNER, SQUAD_DELETE, true},
+		{ADMIN, SQUAD_DELETE, false},
+		{ADMIN, SQUAD_BAN, true},
+		{MODERATOR, SQUAD_KICK, true},
+		{MODERATOR, SQUAD_BAN, false},
+		{MEMBER, SQUAD_RENAME, false},
+		{BANNED, SQUAD_INVITE, false},
+	} {
+		if c.role.Can(c.permission) != c.allowed {
+			t.Errorf("expected %s to have %s: %v", c.role, c.permission, c.allowed)
+		}
+	}
+}
+
+func TestSquadRoles(t *testing.T) {
+	m := NewMemoryManager()
+	tokens := map[string]string{}
+	for _, peer := range []string{"lolo", "lolo2", "lolo3", "lolo4"} {
+		tokens[peer] = newTestSession(t, m, peer)
+	}
+	if err := m.CreateSquad(tokens["lolo"], "0xff", "lolo", "test squad", PUBLIC, "", HOSTED, "lolo"); err != nil {
+		t.Fatal(err)
+	}
+	for _, member := range []string{"lolo2", "lolo3", "lolo4"} {
+		if err := m.ConnectToSquad(tokens[member], "0xff", member, "", "", HOSTED); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.UpdateSquadName(tokens["lolo2"], "0xff", "lolo2", "renamed"); err == nil {
+		t.Error("expected a member to be unable to rename the squad")
+	}
+	if err := m.SetSquadRole(tokens["lolo"], "0xff", "lolo", "lolo2", ADMIN); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.UpdateSquadName(tokens["lolo2"], "0xff", "lolo2", "renamed"); err != nil {
+		t.Error(err)
+	}
+	if err := m.SetSquadRole(tokens["lolo2"], "0xff", "lolo2", "lolo3", ADMIN); err == nil {
+		t.Error("expected an admin to be unable to promote another admin")
+	}
+	if err := m.SetSquadRole(tokens["lolo2"], "0xff", "lolo2", "lolo3", MODERATOR); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.KickFromSquad(tokens["lolo3"], "0xff", "lolo3", "lolo2"); err == nil {
+		t.Error("expected a moderator to be unable to kick an admin")
+	}
+	if err := m.KickFromSquad(tokens["lolo3"], "0xff", "lolo3", "lolo4"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.BanFromSquad(tokens["lolo2"], "0xff", "lolo2", "lolo4"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.ConnectToSquad(tokens["lolo4"], "0xff", "lolo4", "", "", HOSTED); err == nil {
+		t.Error("expected a banned peer to be unable to join")
+	}
+	if err := m.KickFromSquad(tokens["lolo2"], "0xff", "lolo2", "lolo4"); err == nil {
+		t.Error("expected a kick to be unable to lift a ban")
+	}
+	if err := m.DeleteSquad(tokens["lolo2"], "0xff", "lolo2"); err == nil {
+		t.Error("expected an admin to be unable to delete the squad")
+	}
+	if err := m.TransferSquadOwnership(tokens["lolo"], "0xff", "lolo", "lolo2"); err != nil {
+		t.Fatal(err)
+	}
+	squad, err := m.HostedSquadStore.GetSquad(context.Background(), "0xff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if squad.Name != "renamed" || squad.Owner != "lolo2" || squad.Role("lolo") != ADMIN || squad.Role("lolo4") != BANNED {
+		t.Errorf("unexpected squad %+v", squad)
+	}
+	if err = m.DeleteSquad(tokens["lolo2"], "0xff", "lolo2"); err != nil {
+		t.Error(err)
+	}
+}
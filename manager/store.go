@@ -0,0 +1,76 @@
+package manager
+
+import (
+	"context"
+	"time"
+)
+
+type SquadStore interface {
+	AddNewSquad(ctx context.Context, squad *Squad) error
+	GetSquad(ctx context.Context, squadId string) (*Squad, error)
+	GetSquads(ctx context.Context, limit int64, lastIndex int64) ([]*Squad, error)
+	GetSquadsByName(ctx context.Context, pattern string, limit int64, lastIndex int64) ([]*Squad, error)
+	GetSquadsByID(ctx context.Context, pattern string, limit int64, lastIndex int64) ([]*Squad, error)
+	GetSquadsByOwner(ctx context.Context, owner string, limit int64, lastIndex int64) ([]*Squad, error)
+	GetSquadsByHost(ctx context.Context, host string, limit int64, lastIndex int64) ([]*Squad, error)
+	DeleteSquad(ctx context.Context, squadId string) error
+	UpdateSquadName(ctx context.Context, squadId string, newName string) error
+	UpdateSquadPassword(ctx context.Context, squadId string, newPassword string) error
+	UpdateSquadStatus(ctx context.Context, squadId string, newStatus bool) error
+	UpdateSquadMembers(ctx context.Context, squadId string, members []string) error
+	UpdateSquadAuthorizedMembers(ctx context.Context, squadId string, authorizedMembers []string) error
+	UpdateSquadType(ctx context.Context, squadId string, squadType SquadType) error
+	UpdateSquadOwner(ctx context.Context, squadId string, owner string) error
+	UpdateSquadHost(ctx context.Context, squadId string, host string) error
+	UpdateSquadMeshLimit(ctx context.Context, squadId string, limit int) error
+	UpdateSquadRoles(ctx context.Context, squadId string, roles SquadRoles) error
+	AddSquadInvite(ctx context.Context, invite *SquadInvite) error
+	GetSquadInvite(ctx context.Context, code string) (*SquadInvite, error)
+	GetSquadInvites(ctx context.Context, squadId string) ([]*SquadInvite, error)
+	UseSquadInvite(ctx context.Context, code string, now time.Time) error
+	RevokeSquadInvite(ctx context.Context, code string) error
+	GetSquadsByRole(ctx context.Context, peerId string, role SquadRole, limit int64, lastIndex int64) ([]*Squad, error)
+	GetSquadsByMember(ctx context.Context, peerId string, limit int64, lastIndex int64) ([]*Squad, error)
+	AddSquadJoinRequest(ctx context.Context, request *SquadJoinRequest) error
+	GetSquadJoinRequest(ctx context.Context, requestId string) (*SquadJoinRequest, error)
+	GetSquadJoinRequests(ctx context.Context, squadId string) ([]*SquadJoinRequest, error)
+	DeleteSquadJoinRequest(ctx context.Context, requestId string) error
+}
+
+type PeerStore interface {
+	AddNewPeer(ctx context.Context, peer *Peer) error
+	GetPeer(ctx context.Context, peerId string) (*Peer, error)
+	GetPeers(ctx context.Context, limit int64, lastIndex int64) ([]*Peer, error)
+	GetPeersByName(ctx context.Context, pattern string, limit int64, lastIndex int64) ([]*Peer, error)
+	GetPeersByID(ctx context.Context, pattern string, limit int64, lastIndex int64) ([]*Peer, error)
+	DeletePeer(ctx context.Context, peerId string) error
+	UpdatePeerName(ctx context.Context, peerId string, newName string) error
+	UpdatePeerStatus(ctx context.Context, peerId string, newStatus bool) error
+	UpdatePeerPresence(ctx context.Context, peerId string, status PresenceStatus, lastSeen int64) error
+	UpdatePeerKeys(ctx context.Context, peerId string, keys []*PeerKey) error
+	UpdatePeerFriends(ctx context.Context, peerId string, friends []string) error
+	UpdatePeerBlocked(ctx context.Context, peerId string, blocked []string) error
+	AddFriendRequest(ctx context.Context, request *FriendRequest) error
+	GetFriendRequest(ctx context.Context, requestId string) (*FriendRequest, error)
+	GetFriendRequests(ctx context.Context, peerId string) ([]*FriendRequest, error)
+	DeleteFriendRequest(ctx context.Context, requestId string) error
+}
+
+type SessionStore interface {
+	AddSession(ctx context.Context, session *Session) error
+	GetSession(ctx context.Context, token string) (*Session, error)
+	GetSessions(ctx context.Context) ([]*Session, error)
+	UpdateSessionExpiry(ctx context.Context, token string, lastSeen time.Time, expiresAt time.Time) error
+	DeleteSession(ctx context.Context, token string) error
+	DeletePeerSessions(ctx context.Context, peerId string) error
+	DeleteKeySessions(ctx context.Context, peerId string, keyId string) error
+	DeleteExpiredSessions(ctx context.Context, now time.Time) error
+}
+
+type MessageStore interface {
+	AddMessage(ctx context.Context, message *OfflineMessage) error
+	GetMessages(ctx context.Context, peerId string, now time.Time) ([]*OfflineMessage, error)
+	CountMessages(ctx context.Context, peerId string, now time.Time) (int64, error)
+	DeleteMessage(ctx context.Context, peerId string, messageId string) error
+	DeleteExpiredMessages(ctx context.Context, now time.Time) error
+}
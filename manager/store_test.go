@@ -0,0 +1,84 @@
+package manager
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func testSquadStore(t *testing.T, store SquadStore) {
+	for _, squad := range []*Squad{
+		{ID: "0xff", Owner: "lolo", Members: []string{"lolo2"}, AuthorizedMembers: []string{"lolo3"}, Roles: SquadRoles{"lolo2": MODERATOR, "lolo5": BANNED, "lo.lo$": ADMIN}},
+		{ID: "0xfg", Owner: "lolo4", Members: []string{}, AuthorizedMembers: []string{}, Roles: SquadRoles{"lolo6": MODERATOR}},
+	} {
+		if err := store.AddNewSquad(context.Background(), squad); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for peerId, expected := range map[string][]string{"lolo": {"0xff"}, "lolo2": {"0xff"}, "lolo3": {"0xff"}, "lolo4": {"0xfg"}, "lolo5": {}, "lolo6": {}} {
+		squads, err := store.GetSquadsByMember(context.Background(), peerId, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(squads) != len(expected) || (len(squads) == 1 && squads[0].ID != expected[0]) {
+			t.Errorf("expected %s to be a member of %v, got %v", peerId, expected, squads)
+		}
+	}
+	if squads, err := store.GetSquadsByRole(context.Background(), "lolo2", MODERATOR, 0, 0); err != nil || len(squads) != 1 || squads[0].ID != "0xff" {
+		t.Errorf("expected lolo2 to moderate 0xff, got %v %v", squads, err)
+	}
+	if squads, err := store.GetSquadsByRole(context.Background(), "lolo2", ADMIN, 0, 0); err != nil || len(squads) != 0 {
+		t.Errorf("expected lolo2 to administrate no squad, got %v %v", squads, err)
+	}
+	if squads, err := store.GetSquadsByRole(context.Background(), "lo.lo$", ADMIN, 0, 0); err != nil || len(squads) != 1 || squads[0].ID != "0xff" {
+		t.Errorf("expected lo.lo$ to administrate 0xff, got %v %v", squads, err)
+	}
+}
+
+func TestSquadRolesBSON(t *testing.T) {
+	data, err := bson.Marshal(&Squad{ID: "0xff", Roles: SquadRoles{"lolo2": MODERATOR, "lo.lo$": ADMIN}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roles, ok := bson.Raw(data).Lookup("roles").ArrayOK(); !ok {
+		t.Errorf("expected the roles to be stored as an array, got %v", bson.Raw(data).Lookup("roles"))
+	} else if entries, _ := roles.Values(); len(entries) != 2 || entries[0].Document().Lookup("peer").StringValue() != "lo.lo$" {
+		t.Errorf("expected one {peer, role} entry per peer, got %v", roles)
+	}
+	var squad Squad
+	if err = bson.Unmarshal(data, &squad); err != nil {
+		t.Fatal(err)
+	}
+	if len(squad.Roles) != 2 || squad.Roles["lo.lo$"] != ADMIN || squad.Roles["lolo2"] != MODERATOR {
+		t.Errorf("unexpected roles %v", squad.Roles)
+	}
+	legacy, err := bson.Marshal(bson.M{"id": "0xfg", "roles": bson.M{"lolo2": MODERATOR}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = bson.Unmarshal(legacy, &squad); err != nil || len(squad.Roles) != 1 || squad.Roles["lolo2"] != MODERATOR {
+		t.Errorf("expected roles stored as a document to still be read, got %v %v", squad.Roles, err)
+	}
+}
+
+func TestMemorySquadStore(t *testing.T) {
+	testSquadStore(t, NewMemorySquadStore())
+}
+
+func TestMongoSquadStore(t *testing.T) {
+	uri := os.Getenv("ZIPPYTAL_TEST_MONGO_URI")
+	if uri == "" {
+		t.Skip("ZIPPYTAL_TEST_MONGO_URI is not set")
+	}
+	store, err := NewSquadDBManager(uri, "zippytal_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Drop(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	defer store.Drop(context.Background())
+	testSquadStore(t, store)
+}
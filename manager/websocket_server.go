@@ -7,6 +7,8 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/gorilla/websocket"
@@ -24,10 +26,11 @@ type ServRequest struct {
 	From    string            `json:"from"`
 	Token   string            `json:"token"`
 	Payload map[string]string `json:"payload"`
+	Signal  *Signal           `json:"signal,omitempty"`
 }
 
 type WSMiddleware interface {
-	Process(*ServRequest, *Manager, *websocket.Conn) error
+	Process(*ServRequest, *Manager, *WSPeer) error
 }
 
 type HTTPMiddleware interface {
@@ -38,6 +41,7 @@ type WSHandler struct {
 	wsMiddlewares   []WSMiddleware
 	httpMiddlewares []HTTPMiddleware
 	manager         *Manager
+	staticDir       string
 }
 
 type WSServ struct {
@@ -55,44 +59,76 @@ func NewWSServ(addr string, handler http.Handler) (wsServ *WSServ) {
 	return
 }
 
-func NewWSHandler(manager *Manager, wsMiddlewares []WSMiddleware, httpMiddlewares []HTTPMiddleware) (wsHandler *WSHandler) {
+func NewWSHandler(manager *Manager, staticDir string, wsMiddlewares []WSMiddleware, httpMiddlewares []HTTPMiddleware) (wsHandler *WSHandler) {
 	wsHandler = &WSHandler{
 		wsMiddlewares:   wsMiddlewares,
 		httpMiddlewares: httpMiddlewares,
 		manager:         manager,
+		staticDir:       staticDir,
 	}
 	return
 }
 
 func (wsh *WSHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
-	done, errCh := make(chan struct{}), make(chan error)
-	var peerId string
+	done, errCh := make(chan struct{}, 1), make(chan error, 1)
 	go func() {
 		switch req.URL.Path {
 		case "/ws":
-			conn, err := upgrader.Upgrade(w, req, nil)
+			if !wsh.manager.Accepting() {
+				http.Error(w, ErrDraining.Error(), http.StatusServiceUnavailable)
+				errCh <- ErrDraining
+				return
+			}
+			identity, err := wsh.upgradeIdentity(req)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				errCh <- err
+				return
+			}
+			wsConn, err := upgrader.Upgrade(w, req, nil)
 			if err != nil {
 				errCh <- err
 				return
 			}
+			conn := wsh.manager.Router.NewWSPeer(wsConn)
+			var peerId string
+			handled, msgCh := make(chan struct{}), make(chan []byte, 100)
+			defer func() {
+				<-handled
+				wsh.manager.RemoveWSPeer(peerId, conn)
+			}()
 			defer conn.Close()
-			doneCh, msgCh := make(chan struct{}), make(chan []byte, 100)
-			defer close(doneCh)
-			conn.SetCloseHandler(func(code int, text string) error {
-				close(doneCh)
-				close(msgCh)
-				delete(wsh.manager.WSPeers, peerId)
-				return nil
-			})
+			defer close(msgCh)
 			go func() {
+				defer close(handled)
 				for msg := range msgCh {
 					var req ServRequest
 					if err := json.Unmarshal(msg, &req); err != nil {
 						log.Println(err)
 						return
 					}
+					if identity == nil {
+						var err error
+						if identity, err = wsh.initIdentity(&req); err != nil {
+							log.Println(err)
+							wsh.writeError(conn, err)
+							conn.Close()
+							return
+						}
+					}
+					if req.From != "" && req.From != identity.PeerId {
+						log.Printf("peer %s sent a message as %s\n", identity.PeerId, req.From)
+						wsh.writeError(conn, fmt.Errorf("authenticated as %s but sending as %s", identity.PeerId, req.From))
+						continue
+					}
+					req.From = identity.PeerId
 					if req.Type == WS_INIT {
+						if !wsh.manager.Accepting() {
+							wsh.writeError(conn, ErrDraining)
+							conn.Close()
+							return
+						}
 						peerId = req.From
 					}
 					fmt.Println("my cool request", req)
@@ -105,16 +141,16 @@ func (wsh *WSHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 				}
 			}()
 			for {
-				_, message, err := conn.ReadMessage()
+				_, message, err := wsConn.ReadMessage()
 				if err != nil {
 					errCh <- err
-					conn.Close()
-					break
+					return
 				}
+				conn.touch()
 				fmt.Printf("received message %s\n", string(message))
 				select {
 				case msgCh <- message:
-				case <-done:
+				case <-handled:
 					return
 				}
 			}
@@ -142,10 +178,10 @@ func (wsh *WSHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 			}
 			wg.Wait()
 		default:
-			if _, err := os.Stat("./app/" + req.URL.Path); os.IsNotExist(err) {
-				http.ServeFile(w, req, "./app/index.html")
+			if _, err := os.Stat(filepath.Join(wsh.staticDir, req.URL.Path)); os.IsNotExist(err) {
+				http.ServeFile(w, req, filepath.Join(wsh.staticDir, "index.html"))
 			} else {
-				http.ServeFile(w, req, "./app/"+req.URL.Path)
+				http.ServeFile(w, req, filepath.Join(wsh.staticDir, req.URL.Path))
 			}
 		}
 		done <- struct{}{}
@@ -153,13 +189,47 @@ func (wsh *WSHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	select {
 	case <-req.Context().Done():
 		log.Println(req.Context().Err())
-		delete(wsh.manager.WSPeers, peerId)
 		return
 	case <-done:
 		return
 	case err := <-errCh:
 		log.Println(err)
-		delete(wsh.manager.WSPeers, peerId)
 		return
 	}
 }
+
+func (wsh *WSHandler) upgradeIdentity(req *http.Request) (identity *Identity, err error) {
+	token := req.URL.Query().Get("token")
+	if token == "" {
+		token = strings.TrimSpace(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "))
+	}
+	if token == "" {
+		return
+	}
+	identity, err = wsh.manager.AuthManager.Authenticate(token)
+	return
+}
+
+func (wsh *WSHandler) initIdentity(req *ServRequest) (identity *Identity, err error) {
+	if req.Type != WS_INIT {
+		err = fmt.Errorf("the first message must be of type %s", WS_INIT)
+		return
+	}
+	if req.Token == "" {
+		err = fmt.Errorf("no token provided")
+		return
+	}
+	identity, err = wsh.manager.AuthManager.Authenticate(req.Token)
+	return
+}
+
+func (wsh *WSHandler) writeError(conn *WSPeer, err error) {
+	if writeErr := conn.Send(&Envelope{
+		Type: WS_ERROR,
+		Payload: map[string]string{
+			"reason": err.Error(),
+		},
+	}); writeErr != nil {
+		log.Println(writeErr)
+	}
+}
@@ -0,0 +1,118 @@
+package manager
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func newTestWSServer(t *testing.T, m *Manager) (url string) {
+	server := httptest.NewServer(NewWSHandler(m, t.TempDir(), []WSMiddleware{NewWSStateMiddleware()}, nil))
+	t.Cleanup(server.Close)
+	url = "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+	return
+}
+
+func dialTestWS(t *testing.T, url string) *websocket.Conn {
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func readTestWS(t *testing.T, conn *websocket.Conn) (msg map[string]interface{}) {
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	if err := conn.ReadJSON(&msg); err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+func waitForWSPeer(t *testing.T, m *Manager, peerId string) *WSPeer {
+	for i := 0; i < 100; i++ {
+		m.Router.RLock()
+		peer, ok := m.Router.WSPeers[peerId]
+		m.Router.RUnlock()
+		if ok {
+			return peer
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("peer %s was never registered", peerId)
+	return nil
+}
+
+func TestWSInitRequiresSession(t *testing.T) {
+	m := NewMemoryManager()
+	url := newTestWSServer(t, m)
+	if _, _, err := websocket.DefaultDialer.Dial(url+"?token=forged", nil); err == nil {
+		t.Error("expected the upgrade to be refused with an invalid token")
+	}
+	conn := dialTestWS(t, url)
+	if err := conn.WriteJSON(&ServRequest{Type: WS_INIT, From: "lolo"}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := readTestWS(t, conn); msg["type"] != WS_ERROR {
+		t.Errorf("expected an error for an init without token, got %v", msg)
+	}
+	m.Router.RLock()
+	defer m.Router.RUnlock()
+	if _, ok := m.Router.WSPeers["lolo"]; ok {
+		t.Error("expected lolo not to be registered without a session")
+	}
+}
+
+func TestWSBindsIdentity(t *testing.T) {
+	m := NewMemoryManager()
+	url := newTestWSServer(t, m)
+	befriendTestPeers(t, m, "lolo", "lolo2")
+	conn := dialTestWS(t, url+"?token="+newTestSession(t, m, "lolo"))
+	if err := conn.WriteJSON(&ServRequest{Type: WS_INIT, From: "lolo2"}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := readTestWS(t, conn); msg["type"] != WS_ERROR {
+		t.Errorf("expected an error when claiming another peer id, got %v", msg)
+	}
+	if err := conn.WriteJSON(&ServRequest{Type: WS_INIT}); err != nil {
+		t.Fatal(err)
+	}
+	waitForWSPeer(t, m, "lolo")
+	other := dialTestWS(t, url)
+	if err := other.WriteJSON(&ServRequest{Type: WS_INIT, Token: newTestSession(t, m, "lolo2")}); err != nil {
+		t.Fatal(err)
+	}
+	waitForWSPeer(t, m, "lolo2")
+	if err := other.WriteJSON(&ServRequest{Type: "offer", To: "lolo", Payload: map[string]string{}}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := readTestWS(t, conn); msg["from"] != "lolo2" {
+		t.Errorf("expected the message to come from lolo2, got %v", msg)
+	}
+}
+
+func TestWSSessionReplaced(t *testing.T) {
+	m := NewMemoryManager()
+	url := newTestWSServer(t, m)
+	token := newTestSession(t, m, "lolo")
+	first := dialTestWS(t, url+"?token="+token)
+	if err := first.WriteJSON(&ServRequest{Type: WS_INIT}); err != nil {
+		t.Fatal(err)
+	}
+	previous := waitForWSPeer(t, m, "lolo")
+	second := dialTestWS(t, url+"?token="+token)
+	if err := second.WriteJSON(&ServRequest{Type: WS_INIT}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := readTestWS(t, first); msg["type"] != WS_SESSION_REPLACED {
+		t.Errorf("expected the first connection to be replaced, got %v", msg)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if peer := waitForWSPeer(t, m, "lolo"); peer == previous {
+		t.Error("expected the second connection to be registered")
+	}
+}
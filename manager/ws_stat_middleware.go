@@ -1,75 +1,54 @@
 package manager
 
 import (
-	"fmt"
 	"log"
-	"sync"
-
-	"github.com/gorilla/websocket"
 )
 
 const (
-	WS_INIT string  = "init"
-	WS_OPEN WSState = 1
+	WS_INIT             string  = "init"
+	WS_ERROR            string  = "error"
+	WS_SESSION_REPLACED string  = "session_replaced"
+	WS_OPEN             WSState = 1
+	WS_SLEEP            WSState = 2
 )
 
-type WSStateMiddleware struct {
-	lock *sync.Mutex
-}
+type WSStateMiddleware struct{}
 
 func NewWSStateMiddleware() *WSStateMiddleware {
-	return &WSStateMiddleware{
-		lock: &sync.Mutex{},
-	}
+	return &WSStateMiddleware{}
 }
 
-func (wsm *WSStateMiddleware) Process(req *ServRequest, manager *Manager, conn *websocket.Conn) (err error) {
+func (wsm *WSStateMiddleware) Process(req *ServRequest, manager *Manager, conn *WSPeer) (err error) {
 	switch req.Type {
 	case WS_INIT:
-		manager.Lock()
-		conn.SetCloseHandler(func(code int, text string) error {
-			delete(manager.WSPeers, req.From)
+		peerId := req.From
+		conn.Conn.SetCloseHandler(func(code int, text string) error {
+			manager.RemoveWSPeer(peerId, conn)
 			return nil
 		})
-		manager.WSPeers[req.From] = &WSPeer{
-			State: WS_OPEN,
-			Conn:  conn,
-		}
-		manager.Unlock()
-		return
-	default:
-		fmt.Println(manager.WSPeers)
-		if ws, ok := manager.WSPeers[req.To]; ok {
-			wsm.lock.Lock()
-			defer wsm.lock.Unlock()
-			if err = ws.Conn.WriteJSON(map[string]interface{}{
-				"from":    req.From,
-				"to":      req.To,
-				"type":    req.Type,
-				"payload": req.Payload,
+		if previous := manager.Router.addWSPeer(peerId, conn); previous != nil && previous != conn {
+			if err = previous.Send(&Envelope{
+				Type: WS_SESSION_REPLACED,
+				To:   peerId,
 			}); err != nil {
 				log.Println(err)
-				return
-			}
-		} else if grpc, ok := manager.GRPCPeers[req.To]; ok {
-			payload := make(map[string]string)
-			for i, v := range req.Payload {
-				payload[i] = v
 			}
-			payload["to"] = req.To
-			payload["from"] = req.From
-			if err = grpc.Conn.Send(&Response{
-				Type:    req.Type,
-				Success: true,
-				Payload: payload,
-			}); err != nil {
-				log.Println(err)
-				return
-			}
-		} else {
-			err = fmt.Errorf("no corresponding peer for id %s", req.To)
-			return
+			err = previous.Close()
 		}
+		manager.openSession(peerId, req.Payload)
+		return
+	case MESSAGE_ACK:
+		manager.ack(req.From, req.Payload)
+	case MESH_PAIR_STATE:
+		manager.meshPairState(req.From, req.Payload)
+	default:
+		manager.relay(&Envelope{
+			Type:    req.Type,
+			From:    req.From,
+			To:      req.To,
+			Payload: req.Payload,
+			Signal:  req.Signal,
+		})
 	}
 	return
 }